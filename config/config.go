@@ -161,7 +161,8 @@ func GetBool(key string) bool {
 	return false
 }
 
-// AllKeys returns a copy of registered keys
+// AllKeys returns a copy of the keys registered with Add. KnownKeys also
+// includes the keys loaded from config files or bound to environment variables.
 func AllKeys() []string {
 	mu.RLock()
 	defer mu.RUnlock()
@@ -173,6 +174,29 @@ func AllKeys() []string {
 	return keys
 }
 
+// KnownKeys returns every known key, sorted: the keys registered with Add,
+// as returned by AllKeys, and the keys loaded from config files or bound to
+// environment variables.
+func KnownKeys() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	seen := make(map[string]bool, len(registry))
+	for k := range registry {
+		seen[k] = true
+	}
+	for _, k := range viperInstance.AllKeys() {
+		seen[k] = true
+	}
+
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // PrintAll prints all registered keys and resolved values
 func PrintAll() {
 	log.Print("[CONFIG] ==== Registered Configs Value ====")
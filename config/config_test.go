@@ -3,6 +3,8 @@ package config_test
 import (
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"testing"
 
 	"github.com/donnigundala/dg-core/config"
@@ -133,6 +135,31 @@ func TestAllKeys_ReturnsRegisteredKeys(t *testing.T) {
 	}
 }
 
+// TestKnownKeys_IncludesLoadedKeys tests that KnownKeys returns the registered and loaded keys, sorted
+func TestKnownKeys_IncludesLoadedKeys(t *testing.T) {
+	defer resetConfig()
+
+	config.Add("knownkeys", map[string]any{"registered": "value"})
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "known.yaml"), []byte("knownkeys:\n  loaded: value\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+	if err := config.LoadWithPaths(tmpDir); err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	keys := config.KnownKeys()
+	if !sort.StringsAreSorted(keys) {
+		t.Error("Expected the keys to be sorted")
+	}
+	if !slices.Contains(keys, "knownkeys.registered") || !slices.Contains(keys, "knownkeys.loaded") {
+		t.Errorf("Expected the registered and loaded keys, got %v", keys)
+	}
+	if slices.Contains(config.AllKeys(), "knownkeys.loaded") {
+		t.Error("Expected AllKeys to only return the registered keys")
+	}
+}
+
 // TestLoad_YAMLFile tests loading configuration from YAML file
 func TestLoad_YAMLFile(t *testing.T) {
 	defer resetConfig()
@@ -166,6 +193,50 @@ app:
 	}
 }
 
+// TestLoadEnvironment_Overlays tests loading .env.<env> and config/<env> overlays
+func TestLoadEnvironment_Overlays(t *testing.T) {
+	defer resetConfig()
+
+	tmpDir := t.TempDir()
+	overlayDir := filepath.Join(tmpDir, "staging")
+	if err := os.MkdirAll(overlayDir, 0755); err != nil {
+		t.Fatalf("Failed to create overlay dir: %v", err)
+	}
+
+	base := "overlay:\n  name: base\n  region: eu\n"
+	staging := "overlay:\n  name: staging\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, "app.yaml"), []byte(base), 0644); err != nil {
+		t.Fatalf("Failed to create base config: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(overlayDir, "app.yaml"), []byte(staging), 0644); err != nil {
+		t.Fatalf("Failed to create overlay config: %v", err)
+	}
+
+	// .env.<env> is read from the working directory
+	t.Chdir(tmpDir)
+	if err := os.WriteFile(".env.staging", []byte("OVERLAY_TEST_TOKEN=staging-token\n"), 0644); err != nil {
+		t.Fatalf("Failed to create env file: %v", err)
+	}
+	t.Cleanup(func() { os.Unsetenv("OVERLAY_TEST_TOKEN") })
+
+	if err := config.LoadWithPaths(tmpDir); err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if err := config.LoadEnvironment("staging", tmpDir); err != nil {
+		t.Fatalf("Failed to load environment: %v", err)
+	}
+
+	if val := config.GetString("overlay.name"); val != "staging" {
+		t.Errorf("Expected 'staging', got '%s'", val)
+	}
+	if val := config.GetString("overlay.region"); val != "eu" {
+		t.Errorf("Expected 'eu' from the base config, got '%s'", val)
+	}
+	if val := os.Getenv("OVERLAY_TEST_TOKEN"); val != "staging-token" {
+		t.Errorf("Expected 'staging-token', got '%s'", val)
+	}
+}
+
 // TestInject_ValidStruct tests injecting configuration into a struct
 func TestInject_ValidStruct(t *testing.T) {
 	// Note: Config uses global state, so we can't fully reset between tests
@@ -12,6 +12,18 @@ import (
 
 // ------------------------- Loader (env + yaml) -------------------------
 
+// processEnv records the variables of the process environment before any
+// .env file is loaded; .env files never override them.
+var processEnv = func() map[string]bool {
+	keys := make(map[string]bool)
+	for _, kv := range os.Environ() {
+		if k, _, ok := strings.Cut(kv, "="); ok {
+			keys[k] = true
+		}
+	}
+	return keys
+}()
+
 // Load searches for and loads configuration files from default paths.
 // It looks for .env files and any .yaml/.yml files in ./ and ./config/
 // It returns an error if any config file is found but fails to parse.
@@ -72,3 +84,50 @@ func LoadWithPaths(paths ...string) error {
 
 	return nil
 }
+
+// LoadEnvironment loads the overlays of an environment on top of what
+// LoadWithPaths loaded: the .env.<env> file, whose values override .env but
+// not the process environment, and the .yaml/.yml files in <path>/<env>/ for
+// each of the given paths, e.g. ./config/staging/.
+func LoadEnvironment(env string, paths ...string) error {
+	if env == "" {
+		return nil
+	}
+
+	// 1. Load .env.<env>. A missing file is expected.
+	envFile := ".env." + env
+	values, err := godotenv.Read(envFile)
+	if err != nil && !os.IsNotExist(err) {
+		slog.Warn("Failed to load env file", "path", envFile, "error", err)
+	}
+	for key, value := range values {
+		if !processEnv[key] {
+			os.Setenv(key, value)
+		}
+	}
+
+	// 2. Merge the YAML overlays of the environment.
+	for _, path := range paths {
+		dir := filepath.Join(path, env)
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, file := range files {
+			fileName := file.Name()
+			if file.IsDir() || !(strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml")) {
+				continue
+			}
+
+			fullPath := filepath.Join(dir, fileName)
+			viperInstance.SetConfigFile(fullPath)
+			if err := viperInstance.MergeInConfig(); err != nil {
+				return fmt.Errorf("failed to merge config file %s: %w", fullPath, err)
+			}
+			slog.Info("Merged environment config file", "env", env, "path", fullPath)
+		}
+	}
+
+	return nil
+}
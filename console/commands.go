@@ -0,0 +1,325 @@
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/donnigundala/dg-core/config"
+	"github.com/donnigundala/dg-core/container"
+	contractConsole "github.com/donnigundala/dg-core/contracts/console"
+	contractContainer "github.com/donnigundala/dg-core/contracts/container"
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	contractHTTP "github.com/donnigundala/dg-core/contracts/http"
+	coreFoundation "github.com/donnigundala/dg-core/foundation"
+	"github.com/spf13/cobra"
+)
+
+// builtinCommands returns the commands every kernel starts with.
+func builtinCommands(app foundation.Application) []contractConsole.Command {
+	return []contractConsole.Command{
+		&serveCommand{app: app},
+		&configShowCommand{},
+		&routeListCommand{app: app},
+		&providerListCommand{app: app},
+		&containerListCommand{app: app},
+		&aboutCommand{app: app},
+	}
+}
+
+// ------------------------- serve -------------------------
+
+// serveCommand runs the servers until SIGINT or SIGTERM.
+type serveCommand struct {
+	app foundation.Application
+}
+
+func (c *serveCommand) Signature() string { return "serve [servers...]" }
+
+func (c *serveCommand) Description() string {
+	return "Run the HTTP servers until interrupted"
+}
+
+func (c *serveCommand) Configure(cmd *cobra.Command) {
+	cmd.Long = `Run the servers of the http.Manager bound to "` + contractHTTP.ManagerKey + `" (see
+http.ServiceProvider), all of them or the ones named, then shut the application
+down. Without a manager, the servers tagged foundation.TagServers are run by
+Application.Run.`
+}
+
+func (c *serveCommand) Handle(cmd *cobra.Command, args []string) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	instance, err := c.app.Make(contractHTTP.ManagerKey)
+	if err == nil {
+		manager, ok := instance.(interface {
+			Run(ctx context.Context, names ...string) error
+		})
+		if !ok {
+			return fmt.Errorf("'%s' is a %T, not an http.Manager", contractHTTP.ManagerKey, instance)
+		}
+
+		err := manager.Run(ctx, args...)
+		if ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+			err = nil // Stopped by a signal
+		}
+
+		if app, ok := c.app.(interface{ Shutdown(context.Context) error }); ok {
+			err = errors.Join(err, app.Shutdown(context.WithoutCancel(ctx)))
+		}
+		return err
+	}
+
+	// Only a missing manager falls back to Application.Run; a manager that
+	// fails to build, e.g. for a missing dependency, is an error.
+	if !errors.Is(err, container.ErrBindingNotFound) || c.app.Has(contractHTTP.ManagerKey) {
+		return fmt.Errorf("failed to resolve '%s': %w", contractHTTP.ManagerKey, err)
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("servers can only be selected by name with an http.Manager bound to '%s'", contractHTTP.ManagerKey)
+	}
+	if app, ok := c.app.(interface{ Run(context.Context) error }); ok {
+		return app.Run(ctx)
+	}
+	return fmt.Errorf("no http.Manager is bound to '%s'", contractHTTP.ManagerKey)
+}
+
+// ------------------------- config:show -------------------------
+
+// redacted replaces the values of secret configuration keys.
+const redacted = "********"
+
+// secretWords mark configuration keys whose values are redacted.
+var secretWords = []string{"password", "passwd", "secret", "token", "credential", "private", "apikey", "api_key", "dsn"}
+
+// configShowCommand prints the resolved configuration.
+type configShowCommand struct{}
+
+func (c *configShowCommand) Signature() string { return "config:show [prefix]" }
+
+func (c *configShowCommand) Description() string {
+	return "Show the resolved configuration, with secrets redacted"
+}
+
+func (c *configShowCommand) Configure(cmd *cobra.Command) {
+	cmd.Args = cobra.MaximumNArgs(1)
+	addFormatFlag(cmd)
+}
+
+func (c *configShowCommand) Handle(cmd *cobra.Command, args []string) error {
+	prefix := ""
+	if len(args) == 1 {
+		prefix = strings.ToLower(args[0])
+	}
+
+	values := make(map[string]interface{})
+	var rows [][]string
+	for _, key := range config.KnownKeys() {
+		if prefix != "" && key != prefix && !strings.HasPrefix(key, prefix+".") {
+			continue
+		}
+
+		var value interface{} = redacted
+		if !isSecretKey(key) {
+			value = redactUserinfo(config.Get(key))
+		}
+		values[key] = value
+		rows = append(rows, []string{key, fmt.Sprint(value)})
+	}
+
+	return render(cmd, []string{"key", "value"}, rows, values)
+}
+
+// isSecretKey reports whether the last segment of a key names a secret,
+// e.g. database.password, stripe.api_key or jwt.signing_key.
+func isSecretKey(key string) bool {
+	segment := strings.ToLower(key[strings.LastIndex(key, ".")+1:])
+	if segment == "key" || strings.HasSuffix(segment, "_key") || strings.HasSuffix(segment, "-key") {
+		return true
+	}
+	for _, word := range secretWords {
+		if strings.Contains(segment, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactUserinfo redacts the credentials of URL values, such as
+// postgres://app:hunter2@db:5432/app, whatever their key: the password, or
+// the user when it is the only credential, as in https://token@host.
+func redactUserinfo(value interface{}) interface{} {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	scheme, rest, ok := strings.Cut(s, "://")
+	if !ok {
+		return value
+	}
+
+	authority := rest
+	if i := strings.IndexAny(rest, "/?#"); i >= 0 {
+		authority = rest[:i]
+	}
+	at := strings.LastIndex(authority, "@")
+	if at < 0 {
+		return value
+	}
+
+	userinfo := redacted
+	if user, _, ok := strings.Cut(authority[:at], ":"); ok {
+		userinfo = user + ":" + redacted
+	}
+	return scheme + "://" + userinfo + rest[at:]
+}
+
+// ------------------------- route:list -------------------------
+
+// routeListCommand lists the routes of the router bound to "router".
+type routeListCommand struct {
+	app foundation.Application
+}
+
+func (c *routeListCommand) Signature() string { return "route:list" }
+
+func (c *routeListCommand) Description() string { return "List the HTTP routes" }
+
+func (c *routeListCommand) Configure(cmd *cobra.Command) {
+	addFormatFlag(cmd)
+}
+
+func (c *routeListCommand) Handle(cmd *cobra.Command, args []string) error {
+	instance, err := c.app.Make("router")
+	if err != nil {
+		return fmt.Errorf("no router is bound to 'router': %w", err)
+	}
+	router, ok := instance.(contractHTTP.RouteLister)
+	if !ok {
+		return fmt.Errorf("router %T cannot list its routes", instance)
+	}
+
+	routes := router.Routes()
+	rows := make([][]string, len(routes))
+	for i, route := range routes {
+		rows[i] = []string{route.Method, route.Path, route.Name, strings.Join(route.Middleware, ", ")}
+	}
+
+	return render(cmd, []string{"method", "path", "name", "middleware"}, rows, routes)
+}
+
+// ------------------------- provider:list -------------------------
+
+// providerInfo describes a provider in provider:list.
+type providerInfo struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version,omitempty"`
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// providerListCommand lists the registered providers.
+type providerListCommand struct {
+	app foundation.Application
+}
+
+func (c *providerListCommand) Signature() string { return "provider:list" }
+
+func (c *providerListCommand) Description() string {
+	return "List the service providers, with plugin versions and dependencies"
+}
+
+func (c *providerListCommand) Configure(cmd *cobra.Command) {
+	addFormatFlag(cmd)
+}
+
+func (c *providerListCommand) Handle(cmd *cobra.Command, args []string) error {
+	providers := c.app.GetProviders()
+	infos := make([]providerInfo, len(providers))
+	rows := make([][]string, len(providers))
+	for i, provider := range providers {
+		info := providerInfo{Name: fmt.Sprintf("%T", provider)}
+		if plugin, ok := provider.(foundation.PluginProvider); ok {
+			info = providerInfo{Name: plugin.Name(), Version: plugin.Version(), Dependencies: plugin.Dependencies()}
+		}
+		infos[i] = info
+		rows[i] = []string{info.Name, info.Version, strings.Join(info.Dependencies, ", ")}
+	}
+
+	return render(cmd, []string{"name", "version", "dependencies"}, rows, infos)
+}
+
+// ------------------------- container:list -------------------------
+
+// containerListCommand lists the container bindings.
+type containerListCommand struct {
+	app foundation.Application
+}
+
+func (c *containerListCommand) Signature() string { return "container:list" }
+
+func (c *containerListCommand) Description() string {
+	return "List the container bindings and their lifetimes"
+}
+
+func (c *containerListCommand) Configure(cmd *cobra.Command) {
+	addFormatFlag(cmd)
+}
+
+func (c *containerListCommand) Handle(cmd *cobra.Command, args []string) error {
+	keys := c.app.Keys()
+	bindings := make([]contractContainer.BindingInfo, 0, len(keys))
+	rows := make([][]string, 0, len(keys))
+	for _, key := range keys {
+		binding, ok := c.app.Binding(key)
+		if !ok {
+			continue
+		}
+		bindings = append(bindings, binding)
+		rows = append(rows, []string{key, string(binding.Lifetime), binding.Provider, fmt.Sprint(binding.Resolved)})
+	}
+
+	return render(cmd, []string{"key", "lifetime", "provider", "resolved"}, rows, bindings)
+}
+
+// ------------------------- about -------------------------
+
+// aboutCommand prints the environment, plugins and provider timings of the
+// application.
+type aboutCommand struct {
+	app foundation.Application
+}
+
+func (c *aboutCommand) Signature() string { return "about" }
+
+func (c *aboutCommand) Description() string {
+	return "Show the environment, plugins and provider boot timings"
+}
+
+func (c *aboutCommand) Configure(cmd *cobra.Command) {
+	addFormatFlag(cmd)
+}
+
+func (c *aboutCommand) Handle(cmd *cobra.Command, args []string) error {
+	reporter, ok := c.app.(interface{ About() coreFoundation.About })
+	if !ok {
+		return fmt.Errorf("application %T cannot report about itself", c.app)
+	}
+	about := reporter.About()
+
+	format, _ := cmd.Flags().GetString("format")
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(about)
+	case "table", "":
+		return about.WriteText(cmd.OutOrStdout())
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
@@ -0,0 +1,35 @@
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// addFormatFlag adds the --format flag of the listing commands.
+func addFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().String("format", "table", "Output format: table or json")
+}
+
+// render writes the rows as a table, or the value as indented JSON, as
+// chosen with the --format flag.
+func render(cmd *cobra.Command, headers []string, rows [][]string, value interface{}) error {
+	format, _ := cmd.Flags().GetString("format")
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	case "table", "":
+		titles := make([]string, len(headers))
+		for i, header := range headers {
+			titles[i] = strings.ToUpper(header)
+		}
+		IOFrom(cmd).Table(titles, rows)
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
@@ -0,0 +1,404 @@
+package console
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// ErrNoAnswer is returned by prompts without a default when the input is
+// exhausted or the command runs with --no-interaction.
+var ErrNoAnswer = errors.New("no answer given")
+
+// IO is the input and output of a running command. Commands get it with IOFrom:
+//
+//	func (c *MigrateCommand) Handle(cmd *cobra.Command, args []string) error {
+//	    io := console.IOFrom(cmd)
+//	    if ok, _ := io.Confirm("Run the migrations?", false); !ok {
+//	        return nil
+//	    }
+//	    io.Info("Migrated %d tables", n)
+//	    return nil
+//	}
+type IO struct {
+	*Input
+	*Output
+}
+
+type ioKey struct{}
+
+// withIO stores the IO in the context of a command execution.
+func withIO(ctx context.Context, commandIO *IO) context.Context {
+	return context.WithValue(ctx, ioKey{}, commandIO)
+}
+
+// IOFrom returns the IO of a command run by the kernel, or a new one using
+// the input and output of the command.
+func IOFrom(cmd *cobra.Command) *IO {
+	if ctx := cmd.Context(); ctx != nil {
+		if commandIO, ok := ctx.Value(ioKey{}).(*IO); ok {
+			return commandIO
+		}
+	}
+	return &IO{
+		Input:  NewInput(cmd.InOrStdin(), cmd.OutOrStdout()),
+		Output: NewOutput(cmd.OutOrStdout(), cmd.ErrOrStderr()),
+	}
+}
+
+// isTerminal reports whether the stream is an interactive terminal.
+func isTerminal(stream interface{}) bool {
+	file, ok := stream.(*os.File)
+	return ok && term.IsTerminal(int(file.Fd()))
+}
+
+// ------------------------- Output -------------------------
+
+// ANSI styles of the output lines.
+const (
+	styleReset  = "\033[0m"
+	styleGreen  = "\033[32m"
+	styleYellow = "\033[33m"
+	styleRed    = "\033[31m"
+)
+
+// Output writes messages, tables and progress bars. It only uses colors and
+// redraws progress bars when writing to a terminal, and never when NO_COLOR
+// is set or TERM is "dumb".
+type Output struct {
+	out    io.Writer
+	err    io.Writer
+	styled bool
+}
+
+// NewOutput creates an Output writing to out, and errors to err.
+func NewOutput(out, err io.Writer) *Output {
+	return &Output{
+		out:    out,
+		err:    err,
+		styled: isTerminal(out) && os.Getenv("NO_COLOR") == "" && os.Getenv("TERM") != "dumb",
+	}
+}
+
+// Writer returns the writer of the output.
+func (o *Output) Writer() io.Writer {
+	return o.out
+}
+
+// Line writes a plain line.
+func (o *Output) Line(format string, args ...interface{}) {
+	fmt.Fprintf(o.out, format+"\n", args...)
+}
+
+// Info writes an informational line, in green.
+func (o *Output) Info(format string, args ...interface{}) {
+	o.styledLine(o.out, styleGreen, "", format, args...)
+}
+
+// Warn writes a warning line, in yellow.
+func (o *Output) Warn(format string, args ...interface{}) {
+	o.styledLine(o.out, styleYellow, "Warning: ", format, args...)
+}
+
+// Error writes an error line to the error output, in red.
+func (o *Output) Error(format string, args ...interface{}) {
+	o.styledLine(o.err, styleRed, "Error: ", format, args...)
+}
+
+// styledLine writes a line in a color, or with a prefix in plain mode.
+func (o *Output) styledLine(w io.Writer, style, prefix, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	if o.styled {
+		fmt.Fprintln(w, style+message+styleReset)
+		return
+	}
+	fmt.Fprintln(w, prefix+message)
+}
+
+// Table writes rows under headers, in a bordered table:
+//
+//	+--------+-------+
+//	| Name   | Email |
+//	+--------+-------+
+//	| Alice  | a@x   |
+//	+--------+-------+
+func (o *Output) Table(headers []string, rows [][]string) {
+	widths := make([]int, len(headers))
+	for _, row := range append([][]string{headers}, rows...) {
+		for i := 0; i < len(row) && i < len(widths); i++ {
+			widths[i] = max(widths[i], utf8.RuneCountInString(row[i]))
+		}
+	}
+
+	var b strings.Builder
+	border := func() {
+		for _, width := range widths {
+			b.WriteString("+" + strings.Repeat("-", width+2))
+		}
+		b.WriteString("+\n")
+	}
+	line := func(row []string) {
+		for i, width := range widths {
+			cell := ""
+			if i < len(row) {
+				cell = row[i]
+			}
+			b.WriteString("| " + cell + strings.Repeat(" ", width-utf8.RuneCountInString(cell)) + " ")
+		}
+		b.WriteString("|\n")
+	}
+
+	border()
+	line(headers)
+	border()
+	for _, row := range rows {
+		line(row)
+	}
+	if len(rows) > 0 {
+		border()
+	}
+
+	io.WriteString(o.out, b.String())
+}
+
+// Progress starts a progress bar for total steps.
+func (o *Output) Progress(total int) *ProgressBar {
+	bar := &ProgressBar{output: o, total: total}
+	bar.draw()
+	return bar
+}
+
+// ProgressBar shows the progress of a long task. On a terminal it is redrawn
+// as it advances; otherwise only the finished bar is written.
+type ProgressBar struct {
+	output  *Output
+	total   int
+	current int
+}
+
+// progressWidth is the number of characters of the bar.
+const progressWidth = 28
+
+// Advance advances the bar by steps.
+func (p *ProgressBar) Advance(steps int) {
+	p.Set(p.current + steps)
+}
+
+// Set sets the number of completed steps.
+func (p *ProgressBar) Set(current int) {
+	p.current = min(max(current, 0), p.total)
+	p.draw()
+}
+
+// Finish completes the bar and ends its line.
+func (p *ProgressBar) Finish() {
+	p.current = p.total
+	if p.output.styled {
+		p.draw()
+	} else {
+		io.WriteString(p.output.out, p.render())
+	}
+	fmt.Fprintln(p.output.out)
+}
+
+// draw redraws the bar on a terminal.
+func (p *ProgressBar) draw() {
+	if p.output.styled {
+		io.WriteString(p.output.out, "\r"+p.render())
+	}
+}
+
+// render returns the bar, e.g. " 5/10 [==============>-------------]  50%".
+func (p *ProgressBar) render() string {
+	percent := 100
+	if p.total > 0 {
+		percent = p.current * 100 / p.total
+	}
+
+	filled := progressWidth * percent / 100
+	bar := strings.Repeat("=", filled)
+	if filled < progressWidth {
+		bar += ">" + strings.Repeat("-", progressWidth-filled-1)
+	}
+
+	counter := strconv.Itoa(p.total)
+	return fmt.Sprintf("%*d/%s [%s] %3d%%", len(counter), p.current, counter, bar, percent)
+}
+
+// ------------------------- Input -------------------------
+
+// Input asks questions. Answers are read line by line, so tests and scripts
+// can pipe them in; when the input is exhausted, or interaction is disabled,
+// prompts return their default.
+type Input struct {
+	in          io.Reader
+	reader      *bufio.Reader
+	reading     *sync.Mutex // Serializes the prompts sharing the reader
+	out         io.Writer
+	interactive atomic.Bool
+}
+
+// NewInput creates an Input reading answers from in and writing the
+// questions to out.
+func NewInput(in io.Reader, out io.Writer) *Input {
+	return newInput(in, bufio.NewReader(in), &sync.Mutex{}, out, true)
+}
+
+// newInput creates an Input reading answers from a shared buffered reader.
+func newInput(in io.Reader, reader *bufio.Reader, reading *sync.Mutex, out io.Writer, interactive bool) *Input {
+	i := &Input{in: in, reader: reader, reading: reading, out: out}
+	i.interactive.Store(interactive)
+	return i
+}
+
+// share returns an Input reading from the same buffered reader, so answers
+// read ahead by one are not lost to the other, with its own interactivity.
+func (i *Input) share(interactive bool) *Input {
+	return newInput(i.in, i.reader, i.reading, i.out, interactive)
+}
+
+// SetInteractive enables or disables the prompts. When disabled, prompts
+// return their default without asking.
+func (i *Input) SetInteractive(interactive bool) {
+	i.interactive.Store(interactive)
+}
+
+// IsInteractive reports whether the prompts ask questions.
+func (i *Input) IsInteractive() bool {
+	return i.interactive.Load()
+}
+
+// Ask asks a question, returning the default when the answer is empty.
+func (i *Input) Ask(question, defaultAnswer string) (string, error) {
+	prompt := question
+	if defaultAnswer != "" {
+		prompt += " [" + defaultAnswer + "]"
+	}
+
+	answer, err := i.prompt(prompt + ": ")
+	if answer == "" {
+		if defaultAnswer == "" && err != nil {
+			return "", err
+		}
+		return defaultAnswer, nil
+	}
+	return answer, nil
+}
+
+// Confirm asks a yes/no question, returning the default when the answer is empty.
+func (i *Input) Confirm(question string, defaultAnswer bool) (bool, error) {
+	hint := "y/N"
+	if defaultAnswer {
+		hint = "Y/n"
+	}
+
+	for {
+		answer, _ := i.prompt(question + " [" + hint + "]: ")
+		switch strings.ToLower(answer) {
+		case "":
+			return defaultAnswer, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		fmt.Fprintln(i.out, "Please answer yes or no.")
+	}
+}
+
+// Choice asks to pick one of the choices, by number or by value. The default,
+// if not empty, is picked when the answer is empty.
+func (i *Input) Choice(question string, choices []string, defaultChoice string) (string, error) {
+	for {
+		fmt.Fprintln(i.out, question)
+		for n, choice := range choices {
+			fmt.Fprintf(i.out, "  [%d] %s\n", n+1, choice)
+		}
+
+		prompt := "> "
+		if defaultChoice != "" {
+			prompt = "[" + defaultChoice + "] > "
+		}
+		answer, err := i.prompt(prompt)
+		if answer == "" {
+			if defaultChoice == "" && err != nil {
+				return "", err
+			}
+			if defaultChoice != "" {
+				return defaultChoice, nil
+			}
+		}
+
+		if n, convErr := strconv.Atoi(answer); convErr == nil && n >= 1 && n <= len(choices) {
+			return choices[n-1], nil
+		}
+		for _, choice := range choices {
+			if strings.EqualFold(choice, answer) {
+				return choice, nil
+			}
+		}
+		fmt.Fprintf(i.out, "Value %q is invalid.\n", answer)
+	}
+}
+
+// Secret asks a question without echoing the answer on a terminal.
+func (i *Input) Secret(question string) (string, error) {
+	file, ok := i.in.(*os.File)
+	if !ok || !i.IsInteractive() || !isTerminal(file) {
+		answer, err := i.prompt(question + ": ")
+		if answer == "" && err != nil {
+			return "", err
+		}
+		return answer, nil
+	}
+
+	// A terminal hands over a line per read, so nothing is left buffered in
+	// the reader when the answer is read from the terminal directly.
+	i.reading.Lock()
+	defer i.reading.Unlock()
+	io.WriteString(i.out, question+": ")
+	answer, err := term.ReadPassword(int(file.Fd()))
+	fmt.Fprintln(i.out)
+	if err != nil {
+		return "", fmt.Errorf("cannot read the answer: %w", err)
+	}
+	return strings.TrimSpace(string(answer)), nil
+}
+
+// prompt writes the prompt and reads one line. It returns ErrNoAnswer when
+// the input is exhausted or interaction is disabled.
+func (i *Input) prompt(prompt string) (string, error) {
+	if !i.IsInteractive() {
+		return "", ErrNoAnswer
+	}
+
+	i.reading.Lock()
+	defer i.reading.Unlock()
+	io.WriteString(i.out, prompt)
+	line, err := i.reader.ReadString('\n')
+	line = strings.TrimSpace(line)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			// Keep the output on its own line when the input had no newline
+			fmt.Fprintln(i.out)
+			if line == "" {
+				i.SetInteractive(false)
+				return "", ErrNoAnswer
+			}
+			return line, nil
+		}
+		return line, err
+	}
+	return line, nil
+}
@@ -0,0 +1,154 @@
+package console_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/donnigundala/dg-core/console"
+	contractConsole "github.com/donnigundala/dg-core/contracts/console"
+	coreFoundation "github.com/donnigundala/dg-core/foundation"
+	"github.com/spf13/cobra"
+)
+
+// TestOutput_PlainLines tests that output which is not a terminal has no colors.
+func TestOutput_PlainLines(t *testing.T) {
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	output := console.NewOutput(out, errOut)
+
+	output.Info("Migrated %d tables", 3)
+	output.Warn("Cache is disabled")
+	output.Error("Connection refused")
+
+	if want := "Migrated 3 tables\nWarning: Cache is disabled\n"; out.String() != want {
+		t.Errorf("Expected %q, got %q", want, out.String())
+	}
+	if want := "Error: Connection refused\n"; errOut.String() != want {
+		t.Errorf("Expected %q, got %q", want, errOut.String())
+	}
+}
+
+// TestOutput_Table tests that the columns are aligned to their widest cell.
+func TestOutput_Table(t *testing.T) {
+	out := &bytes.Buffer{}
+	console.NewOutput(out, out).Table([]string{"Name", "Email"}, [][]string{
+		{"Alice", "alice@example.com"},
+		{"Bob"},
+	})
+
+	want := `+-------+-------------------+
+| Name  | Email             |
++-------+-------------------+
+| Alice | alice@example.com |
+| Bob   |                   |
++-------+-------------------+
+`
+	if out.String() != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, out.String())
+	}
+}
+
+// TestOutput_ProgressPlain tests that a progress bar is only written once finished when not on a terminal.
+func TestOutput_ProgressPlain(t *testing.T) {
+	out := &bytes.Buffer{}
+	bar := console.NewOutput(out, out).Progress(4)
+	bar.Advance(1)
+	bar.Advance(2)
+	if out.Len() != 0 {
+		t.Fatalf("Expected no output before Finish, got %q", out.String())
+	}
+
+	bar.Finish()
+	if want := "4/4 [============================] 100%\n"; out.String() != want {
+		t.Errorf("Expected %q, got %q", want, out.String())
+	}
+}
+
+// TestInput_ScriptedAnswers tests the prompts with answers read from the input.
+func TestInput_ScriptedAnswers(t *testing.T) {
+	out := &bytes.Buffer{}
+	input := console.NewInput(strings.NewReader("maybe\nyes\n\n2\nhunter2\n"), out)
+
+	if ok, err := input.Confirm("Continue?", false); !ok || err != nil {
+		t.Errorf("Expected yes after an invalid answer, got %v, %v", ok, err)
+	}
+	if name, _ := input.Ask("Name", "Alice"); name != "Alice" {
+		t.Errorf("Expected the default name, got %q", name)
+	}
+	if driver, _ := input.Choice("Driver", []string{"mysql", "postgres"}, ""); driver != "postgres" {
+		t.Errorf("Expected postgres, got %q", driver)
+	}
+	if secret, _ := input.Secret("Password"); secret != "hunter2" {
+		t.Errorf("Expected the secret, got %q", secret)
+	}
+	if !strings.Contains(out.String(), "Please answer yes or no.") {
+		t.Errorf("Expected the invalid answer to be reported, got %q", out.String())
+	}
+}
+
+// TestInput_ExhaustedInput tests that prompts fall back to their default once the input is exhausted.
+func TestInput_ExhaustedInput(t *testing.T) {
+	input := console.NewInput(strings.NewReader(""), &bytes.Buffer{})
+
+	if ok, err := input.Confirm("Continue?", true); !ok || err != nil {
+		t.Errorf("Expected the default, got %v, %v", ok, err)
+	}
+	if driver, _ := input.Choice("Driver", []string{"mysql", "postgres"}, "mysql"); driver != "mysql" {
+		t.Errorf("Expected the default choice, got %q", driver)
+	}
+	if _, err := input.Ask("Name", ""); !errors.Is(err, console.ErrNoAnswer) {
+		t.Errorf("Expected ErrNoAnswer, got %v", err)
+	}
+}
+
+// promptCommand asks a question and prints the answer.
+type promptCommand struct{}
+
+func (c *promptCommand) Signature() string            { return "prompt" }
+func (c *promptCommand) Description() string          { return "Ask a question" }
+func (c *promptCommand) Configure(cmd *cobra.Command) {}
+func (c *promptCommand) Handle(cmd *cobra.Command, args []string) error {
+	io := console.IOFrom(cmd)
+	name, err := io.Ask("Name", "nobody")
+	if err != nil {
+		return err
+	}
+	io.Info("Hello, %s", name)
+	return nil
+}
+
+// TestKernel_CommandIO tests that commands get their answers from the kernel input.
+func TestKernel_CommandIO(t *testing.T) {
+	kernel := console.NewKernel(coreFoundation.New(t.TempDir()))
+	kernel.Register([]contractConsole.Command{&promptCommand{}})
+	out := &bytes.Buffer{}
+	kernel.SetOut(out)
+
+	kernel.SetIn(strings.NewReader("Alice\n"))
+	if err := kernel.Call("prompt", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.HasSuffix(out.String(), "Hello, Alice\n") {
+		t.Errorf("Expected the answer to be used, got %q", out.String())
+	}
+
+	// --no-interaction uses the default without reading the input
+	out.Reset()
+	kernel.SetIn(strings.NewReader("Bob\n"))
+	if err := kernel.Call("prompt", []string{"-n"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if out.String() != "Hello, nobody\n" {
+		t.Errorf("Expected the default without a prompt, got %q", out.String())
+	}
+
+	// --no-interaction does not outlast its call
+	out.Reset()
+	if err := kernel.Call("prompt", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.HasSuffix(out.String(), "Hello, Bob\n") {
+		t.Errorf("Expected the next call to prompt, got %q", out.String())
+	}
+}
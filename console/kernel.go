@@ -0,0 +1,301 @@
+// Package console implements the console kernel: a cobra command tree built
+// from the console.Command implementations registered with the kernel or
+// returned by CommandProvider providers.
+package console
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	contractConsole "github.com/donnigundala/dg-core/contracts/console"
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	coreFoundation "github.com/donnigundala/dg-core/foundation"
+	"github.com/spf13/cobra"
+)
+
+// Kernel is the concrete implementation of the console kernel.
+type Kernel struct {
+	app          foundation.Application
+	name         string
+	commands     map[string]contractConsole.Command
+	builtin      map[string]bool
+	bootstrapped bool
+
+	mu  sync.Mutex // Guards the input and outputs, set while commands run
+	in  io.Reader
+	out io.Writer
+	err io.Writer
+	io  *IO // Shared by the executions, see commandIO
+}
+
+// NewKernel creates a new Kernel instance with the built-in commands (serve,
+// config:show, route:list, provider:list, container:list and about) and binds
+// it to the container under "console", so commands can call each other. The root
+// command is named after the executable.
+func NewKernel(app foundation.Application) *Kernel {
+	k := &Kernel{
+		app:      app,
+		name:     filepath.Base(os.Args[0]),
+		commands: make(map[string]contractConsole.Command),
+		builtin:  make(map[string]bool),
+		in:       os.Stdin,
+		out:      os.Stdout,
+		err:      os.Stderr,
+	}
+	for _, command := range builtinCommands(app) {
+		k.commands[commandName(command)] = command
+		k.builtin[commandName(command)] = true
+	}
+
+	app.Instance("console", k)
+	return k
+}
+
+// SetName sets the name of the root command, shown in the help.
+func (k *Kernel) SetName(name string) {
+	k.name = name
+}
+
+// SetIn sets the input of the commands. Defaults to os.Stdin. Prompts read
+// their answers from it line by line, so tests can script them with
+// strings.NewReader("yes\nAlice\n").
+func (k *Kernel) SetIn(in io.Reader) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.in = in
+	k.io = nil
+}
+
+// SetOut sets the output of the commands. Defaults to os.Stdout.
+func (k *Kernel) SetOut(out io.Writer) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.out = out
+	k.io = nil
+}
+
+// SetErr sets the error output of the commands. Defaults to os.Stderr.
+func (k *Kernel) SetErr(err io.Writer) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.err = err
+	k.io = nil
+}
+
+// Register registers commands with the kernel. A command replaces any
+// command registered before with the same name.
+func (k *Kernel) Register(commands []contractConsole.Command) {
+	for _, command := range commands {
+		k.commands[commandName(command)] = command
+		delete(k.builtin, commandName(command))
+	}
+}
+
+// Commands returns the registered commands, sorted by name.
+func (k *Kernel) Commands() []contractConsole.Command {
+	names := make([]string, 0, len(k.commands))
+	for name := range k.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	commands := make([]contractConsole.Command, len(names))
+	for i, name := range names {
+		commands[i] = k.commands[name]
+	}
+	return commands
+}
+
+// Bootstrap loads the configuration, registers the configured providers and
+// boots the application, as Application.Run does, then registers the commands
+// of every provider implementing CommandProvider. Provider commands replace built-in
+// commands, while commands registered with Register take precedence over
+// provider commands with the same name. Deferred providers implementing
+// CommandProvider are loaded, so their commands are always available.
+func (k *Kernel) Bootstrap() error {
+	if k.bootstrapped {
+		return nil
+	}
+
+	if !k.app.IsBooted() {
+		if loader, ok := k.app.(interface {
+			LoadConfig() error
+			RegisterConfigured() error
+		}); ok {
+			if err := loader.LoadConfig(); err != nil {
+				return err
+			}
+			if err := loader.RegisterConfigured(); err != nil {
+				return err
+			}
+		}
+
+		if err := k.app.Boot(); err != nil {
+			return fmt.Errorf("failed to boot application: %w", err)
+		}
+	}
+
+	// Deferred providers are loaded now if they add commands
+	if deferrer, ok := k.app.(interface {
+		DeferredProviders() []foundation.DeferredProvider
+		LoadDeferred(provider foundation.DeferredProvider) error
+	}); ok {
+		for _, provider := range deferrer.DeferredProviders() {
+			if _, ok := provider.(foundation.CommandProvider); !ok {
+				continue
+			}
+			if err := deferrer.LoadDeferred(provider); err != nil {
+				return fmt.Errorf("failed to load deferred provider %T: %w", provider, err)
+			}
+		}
+	}
+
+	for _, provider := range k.app.GetProviders() {
+		commandProvider, ok := provider.(foundation.CommandProvider)
+		if !ok {
+			continue
+		}
+
+		for _, c := range commandProvider.Commands() {
+			command, ok := c.(contractConsole.Command)
+			if !ok {
+				return fmt.Errorf("provider %T returned %T, which does not implement console.Command", provider, c)
+			}
+			name := commandName(command)
+			if _, exists := k.commands[name]; !exists || k.builtin[name] {
+				k.commands[name] = command
+				delete(k.builtin, name)
+			}
+		}
+	}
+
+	k.bootstrapped = true
+	return nil
+}
+
+// Handle bootstraps the application and runs the command named by the
+// process arguments.
+//
+// Example:
+//
+//	func main() {
+//	    app := foundation.New(".")
+//	    // register providers...
+//	    if err := console.NewKernel(app).Handle(); err != nil {
+//	        os.Exit(1)
+//	    }
+//	}
+func (k *Kernel) Handle() error {
+	args := os.Args[1:]
+	if err := k.bootstrap(args); err != nil {
+		return err
+	}
+	return k.execute(context.Background(), args)
+}
+
+// Call runs a command by name with the given arguments and flags, e.g.
+// Call("migrate:up", []string{"--force"}). Commands may call each other,
+// from any goroutine.
+func (k *Kernel) Call(command string, args []string) error {
+	return k.CallContext(context.Background(), command, args)
+}
+
+// CallContext runs a command like Call, as part of the command execution
+// whose context is ctx, if any. A command calling another one passes its
+// cmd.Context(), so the called command shares its IO and does not ask
+// questions when it runs with --no-interaction.
+func (k *Kernel) CallContext(ctx context.Context, command string, args []string) error {
+	if err := k.bootstrap(args); err != nil {
+		return err
+	}
+	if _, ok := k.commands[command]; !ok {
+		return fmt.Errorf("command '%s' is not registered", command)
+	}
+	return k.execute(ctx, append([]string{command}, args...))
+}
+
+// bootstrap applies the --env flag of the arguments, which decides the
+// configuration loaded for the providers, then bootstraps the application.
+func (k *Kernel) bootstrap(args []string) error {
+	if env := coreFoundation.EnvFlag(args); env != "" && !k.bootstrapped {
+		if setter, ok := k.app.(interface{ SetEnvironment(string) }); ok {
+			setter.SetEnvironment(env)
+		}
+	}
+	return k.Bootstrap()
+}
+
+// execute runs the arguments through a fresh command tree, so flag values
+// never leak from one execution to the next.
+func (k *Kernel) execute(ctx context.Context, args []string) error {
+	root := k.rootCommand()
+	root.SetArgs(args)
+	return root.ExecuteContext(withIO(ctx, k.commandIO(ctx)))
+}
+
+// commandIO returns the IO of an execution. Executions share the input
+// reader, so answers buffered by one prompt are not lost when a command calls
+// another, but --no-interaction only applies to the execution it is given to
+// and to the commands called with its context.
+func (k *Kernel) commandIO(ctx context.Context) *IO {
+	interactive := true
+	if caller, ok := ctx.Value(ioKey{}).(*IO); ok {
+		interactive = caller.IsInteractive()
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.io == nil {
+		k.io = &IO{Input: NewInput(k.in, k.out), Output: NewOutput(k.out, k.err)}
+	}
+	return &IO{Input: k.io.share(interactive), Output: k.io.Output}
+}
+
+// rootCommand builds the cobra command tree of the registered commands.
+func (k *Kernel) rootCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:          k.name,
+		SilenceUsage: true,
+	}
+	root.PersistentFlags().String("env", "", "The environment the command should run under")
+	root.PersistentFlags().BoolP("no-interaction", "n", false, "Do not ask any question, use the defaults")
+	root.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		if noInteraction, _ := cmd.Flags().GetBool("no-interaction"); noInteraction {
+			IOFrom(cmd).SetInteractive(false)
+		}
+	}
+	k.mu.Lock()
+	root.SetIn(k.in)
+	root.SetOut(k.out)
+	root.SetErr(k.err)
+	k.mu.Unlock()
+
+	for _, command := range k.Commands() {
+		root.AddCommand(cobraCommand(command))
+	}
+
+	return root
+}
+
+// cobraCommand wraps a console command into a cobra command.
+func cobraCommand(command contractConsole.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   command.Signature(),
+		Short: command.Description(),
+		RunE:  command.Handle,
+	}
+	command.Configure(cmd)
+	return cmd
+}
+
+// commandName returns the name of a command: the first word of its signature.
+func commandName(command contractConsole.Command) string {
+	name, _, _ := strings.Cut(strings.TrimSpace(command.Signature()), " ")
+	return name
+}
@@ -0,0 +1,204 @@
+package console_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/donnigundala/dg-core/config"
+	"github.com/donnigundala/dg-core/console"
+	contractConsole "github.com/donnigundala/dg-core/contracts/console"
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	coreFoundation "github.com/donnigundala/dg-core/foundation"
+	"github.com/spf13/cobra"
+)
+
+// greetCommand prints a greeting for its argument.
+type greetCommand struct {
+	calls int
+}
+
+func (c *greetCommand) Signature() string   { return "greet [name]" }
+func (c *greetCommand) Description() string { return "Greet someone" }
+
+func (c *greetCommand) Configure(cmd *cobra.Command) {
+	cmd.Flags().Bool("shout", false, "Greet loudly")
+}
+
+func (c *greetCommand) Handle(cmd *cobra.Command, args []string) error {
+	c.calls++
+	greeting := "hello " + strings.Join(args, " ")
+	if shout, _ := cmd.Flags().GetBool("shout"); shout {
+		greeting = strings.ToUpper(greeting)
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), greeting)
+	return nil
+}
+
+// commandProvider provides a command through CommandProvider.
+type commandProvider struct {
+	commands []interface{}
+	booted   bool
+}
+
+func (p *commandProvider) Register(app foundation.Application) error { return nil }
+func (p *commandProvider) Boot(app foundation.Application) error {
+	p.booted = true
+	return nil
+}
+func (p *commandProvider) Commands() []interface{} { return p.commands }
+
+func newKernel(t *testing.T) (*console.Kernel, *bytes.Buffer) {
+	t.Helper()
+
+	out := &bytes.Buffer{}
+	kernel := console.NewKernel(coreFoundation.New(t.TempDir()))
+	kernel.SetOut(out)
+	kernel.SetErr(out)
+	return kernel, out
+}
+
+// TestKernel_Call tests that Call runs a registered command with its flags.
+func TestKernel_Call(t *testing.T) {
+	kernel, out := newKernel(t)
+	kernel.Register([]contractConsole.Command{&greetCommand{}})
+
+	if err := kernel.Call("greet", []string{"--shout", "world"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := out.String(); got != "HELLO WORLD\n" {
+		t.Errorf("Expected shouted greeting, got %q", got)
+	}
+
+	// Flags do not leak into the next call
+	out.Reset()
+	if err := kernel.Call("greet", []string{"again"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := out.String(); got != "hello again\n" {
+		t.Errorf("Expected plain greeting, got %q", got)
+	}
+}
+
+// TestKernel_CallUnknown tests that calling an unknown command fails.
+func TestKernel_CallUnknown(t *testing.T) {
+	kernel, _ := newKernel(t)
+
+	err := kernel.Call("missing", nil)
+	if err == nil || !strings.Contains(err.Error(), "command 'missing' is not registered") {
+		t.Errorf("Expected a not registered error, got %v", err)
+	}
+}
+
+// TestKernel_ProviderCommands tests that commands are collected from providers while bootstrapping.
+func TestKernel_ProviderCommands(t *testing.T) {
+	app := coreFoundation.New(t.TempDir())
+	greet := &greetCommand{}
+	provider := &commandProvider{commands: []interface{}{greet}}
+	if err := app.Register(provider); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	kernel := console.NewKernel(app)
+	kernel.SetOut(&bytes.Buffer{})
+	if err := kernel.Call("greet", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !provider.booted {
+		t.Error("Expected the application to be booted")
+	}
+	if greet.calls != 1 {
+		t.Errorf("Expected the provider command to run once, ran %d times", greet.calls)
+	}
+	if n := len(kernel.Commands()); n != 7 {
+		t.Errorf("Expected the built-in commands and greet, got %d commands", n)
+	}
+}
+
+// TestKernel_ConfiguredProviderCommands tests that providers listed in app.providers are registered while bootstrapping.
+func TestKernel_ConfiguredProviderCommands(t *testing.T) {
+	greet := &greetCommand{}
+	if !slices.Contains(coreFoundation.Factories(), "kernel-greeter") {
+		coreFoundation.RegisterFactory("kernel-greeter", func() coreFoundation.ServiceProvider {
+			return &commandProvider{commands: []interface{}{greet}}
+		})
+	}
+	t.Setenv("APP_PROVIDERS", "kernel-greeter")
+
+	kernel, out := newKernel(t)
+	if err := kernel.Call("greet", []string{"configured"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := out.String(); got != "hello configured\n" {
+		t.Errorf("Expected the configured provider command to run, got %q", got)
+	}
+}
+
+// deferredCommandProvider is a deferred provider that adds commands.
+type deferredCommandProvider struct {
+	commandProvider
+}
+
+func (p *deferredCommandProvider) Provides() []string { return []string{"greeter"} }
+
+// TestKernel_DeferredProviderCommands tests that deferred providers adding commands are loaded.
+func TestKernel_DeferredProviderCommands(t *testing.T) {
+	app := coreFoundation.New(t.TempDir())
+	greet := &greetCommand{}
+	provider := &deferredCommandProvider{commandProvider{commands: []interface{}{greet}}}
+	if err := app.Register(provider); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	kernel := console.NewKernel(app)
+	kernel.SetOut(&bytes.Buffer{})
+	if err := kernel.Call("greet", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !provider.booted || greet.calls != 1 {
+		t.Errorf("Expected the deferred provider to be loaded and its command to run, booted: %v, calls: %d", provider.booted, greet.calls)
+	}
+}
+
+// TestKernel_InvalidProviderCommand tests that providers must return console commands.
+func TestKernel_InvalidProviderCommand(t *testing.T) {
+	app := coreFoundation.New(t.TempDir())
+	app.Register(&commandProvider{commands: []interface{}{"not a command"}})
+
+	err := console.NewKernel(app).Bootstrap()
+	if err == nil || !strings.Contains(err.Error(), "does not implement console.Command") {
+		t.Errorf("Expected an invalid command error, got %v", err)
+	}
+}
+
+// TestKernel_EnvFlag tests that --env sets the environment before the configuration is loaded.
+func TestKernel_EnvFlag(t *testing.T) {
+	basePath := t.TempDir()
+	overlay := filepath.Join(basePath, "config", "staging")
+	if err := os.MkdirAll(overlay, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(overlay, "kernel.yaml"), []byte("kerneltest:\n  env: staging\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	app := coreFoundation.New(basePath)
+	kernel := console.NewKernel(app)
+	kernel.SetOut(&bytes.Buffer{})
+	kernel.Register([]contractConsole.Command{&greetCommand{}})
+
+	if err := kernel.Call("greet", []string{"--env", "staging"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if env := app.Environment(); env != "staging" {
+		t.Errorf("Expected staging environment, got %q", env)
+	}
+	if value := config.GetString("kerneltest.env"); value != "staging" {
+		t.Errorf("Expected the staging configuration to be loaded, got %q", value)
+	}
+}
@@ -0,0 +1,107 @@
+package container
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/donnigundala/dg-core/contracts/container"
+)
+
+var (
+	// containerTypes are the parameter types that receive the container itself.
+	containerTypes = []reflect.Type{
+		reflect.TypeOf((*container.Container)(nil)).Elem(),
+		reflect.TypeOf((*Container)(nil)).Elem(),
+	}
+
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Wiring pairs a constructor with explicit container keys for some of its
+// parameters. Create one with Wire.
+type Wiring struct {
+	fn   interface{}
+	keys map[int]string
+}
+
+// Wire maps constructor parameters (by position) to container keys.
+//
+// Parameters that are not listed in keys are resolved by type, exactly as
+// for a plain constructor passed to Bind or Singleton:
+//
+//	// Parameter 0 comes from the "db" key, parameter 1 is resolved by type.
+//	c.Singleton("users", container.Wire(NewUserService, map[int]string{0: "db"}))
+func Wire(fn interface{}, keys map[int]string) Wiring {
+	return Wiring{fn: fn, keys: keys}
+}
+
+// isContainerType reports whether a parameter of type t should receive the container.
+func isContainerType(t reflect.Type) bool {
+	for _, ct := range containerTypes {
+		if t == ct {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveArgs resolves every parameter of the resolver function from the container.
+//
+// Container parameters receive the container itself, parameters listed in keys
+// are resolved by key and all remaining parameters are resolved by their type key.
+func (r *resolution) resolveArgs(key string, fnType reflect.Type, keys map[int]string) ([]reflect.Value, error) {
+	if fnType.IsVariadic() {
+		return nil, fmt.Errorf("variadic resolver is not supported for key: %s", key)
+	}
+
+	args := make([]reflect.Value, fnType.NumIn())
+	for i := 0; i < fnType.NumIn(); i++ {
+		paramType := fnType.In(i)
+
+		depKey, keyed := keys[i]
+		if !keyed && isContainerType(paramType) {
+			args[i] = reflect.ValueOf(r)
+			continue
+		}
+		if !keyed {
+			depKey = typeKey(paramType)
+		}
+
+		dep, err := r.Make(depKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve parameter %d (%s) of '%s': %w", i, paramType, key, err)
+		}
+
+		if dep == nil {
+			args[i] = reflect.Zero(paramType)
+			continue
+		}
+
+		depVal := reflect.ValueOf(dep)
+		if !depVal.Type().AssignableTo(paramType) {
+			return nil, fmt.Errorf("failed to resolve parameter %d of '%s': expected %s, got %T", i, key, paramType, dep)
+		}
+		args[i] = depVal
+	}
+
+	return args, nil
+}
+
+// call invokes the resolver with the prepared arguments and interprets its results.
+//
+// Resolvers may return a single value or a value and an error. A non-nil error
+// is passed back to the caller of Make.
+func call(key string, fn reflect.Value, args []reflect.Value) (interface{}, error) {
+	results := fn.Call(args)
+	if len(results) == 0 {
+		return nil, fmt.Errorf("resolver returned no results for key: %s", key)
+	}
+
+	if len(results) == 2 && fn.Type().Out(1).Implements(errorType) {
+		if err, _ := results[1].Interface().(error); err != nil {
+			return nil, fmt.Errorf("resolver for '%s' failed: %w", key, err)
+		}
+	}
+
+	return results[0].Interface(), nil
+}
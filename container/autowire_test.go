@@ -0,0 +1,142 @@
+package container_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+	contractContainer "github.com/donnigundala/dg-core/contracts/container"
+)
+
+type wireLogger struct {
+	Prefix string
+}
+
+type wireService struct {
+	DB     *genericDatabase
+	Logger *wireLogger
+}
+
+// TestAutowire_ByType tests that constructor parameters are resolved by type
+func TestAutowire_ByType(t *testing.T) {
+	c := container.NewContainer()
+	container.InstanceType(c, &genericDatabase{Host: "localhost"})
+	container.InstanceType(c, &wireLogger{Prefix: "app"})
+
+	c.Singleton("service", func(db *genericDatabase, logger *wireLogger) *wireService {
+		return &wireService{DB: db, Logger: logger}
+	})
+
+	svc, err := container.Resolve[*wireService](c, "service")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if svc.DB.Host != "localhost" || svc.Logger.Prefix != "app" {
+		t.Errorf("Expected dependencies to be injected, got %+v", svc)
+	}
+}
+
+// TestAutowire_ErrorReturn tests that a resolver error is passed back from Make
+func TestAutowire_ErrorReturn(t *testing.T) {
+	c := container.NewContainer()
+	errBoom := errors.New("boom")
+
+	c.Singleton("failing", func() (*wireService, error) {
+		return nil, errBoom
+	})
+
+	instance, err := c.Make("failing")
+	if instance != nil {
+		t.Errorf("Expected nil instance, got %v", instance)
+	}
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("Expected wrapped resolver error, got %v", err)
+	}
+
+	// Failed singletons are not cached
+	c.Singleton("ok", func() (*wireService, error) {
+		return &wireService{}, nil
+	})
+	if _, err := c.Make("ok"); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+// TestAutowire_WireKeys tests mapping parameters to explicit keys
+func TestAutowire_WireKeys(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("primary", &genericDatabase{Host: "primary"})
+	container.InstanceType(c, &genericDatabase{Host: "by-type"})
+	container.InstanceType(c, &wireLogger{})
+
+	c.Bind("service", container.Wire(func(db *genericDatabase, logger *wireLogger) *wireService {
+		return &wireService{DB: db, Logger: logger}
+	}, map[int]string{0: "primary"}))
+
+	svc, err := container.Resolve[*wireService](c, "service")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if svc.DB.Host != "primary" {
+		t.Errorf("Expected keyed parameter to resolve 'primary', got %s", svc.DB.Host)
+	}
+	if svc.Logger == nil {
+		t.Error("Expected unkeyed parameter to be resolved by type")
+	}
+}
+
+// TestAutowire_MissingDependency tests the error for an unresolvable parameter
+func TestAutowire_MissingDependency(t *testing.T) {
+	c := container.NewContainer()
+	c.Bind("service", func(db *genericDatabase) *wireService {
+		return &wireService{DB: db}
+	})
+
+	_, err := c.Make("service")
+	if err == nil {
+		t.Fatal("Expected error for missing dependency")
+	}
+	expectedSubstrings := []string{"parameter 0", "service", "binding not found"}
+	for _, substr := range expectedSubstrings {
+		if !contains(err.Error(), substr) {
+			t.Errorf("Expected error to contain '%s', got: %s", substr, err.Error())
+		}
+	}
+}
+
+// TestAutowire_WrongKeyedType tests the error when a keyed dependency has the wrong type
+func TestAutowire_WrongKeyedType(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("db", "not a database")
+	c.Bind("service", container.Wire(func(db *genericDatabase) *wireService {
+		return &wireService{DB: db}
+	}, map[int]string{0: "db"}))
+
+	_, err := c.Make("service")
+	if err == nil || !contains(err.Error(), "expected *container_test.genericDatabase, got string") {
+		t.Errorf("Expected type mismatch error, got %v", err)
+	}
+}
+
+// TestAutowire_ContainerAndTypes tests mixing the container parameter with typed ones
+func TestAutowire_ContainerAndTypes(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("prefix", "svc")
+	container.InstanceType(c, &genericDatabase{Host: "localhost"})
+
+	c.Bind("service", func(c contractContainer.Container, db *genericDatabase) (*wireService, error) {
+		prefix, err := container.Resolve[string](c, "prefix")
+		if err != nil {
+			return nil, err
+		}
+		return &wireService{DB: db, Logger: &wireLogger{Prefix: prefix}}, nil
+	})
+
+	svc, err := container.Resolve[*wireService](c, "service")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if svc.Logger.Prefix != "svc" || svc.DB.Host != "localhost" {
+		t.Errorf("Unexpected service: %+v", svc)
+	}
+}
@@ -1,6 +1,6 @@
 // Package container provides a dependency injection container for managing application dependencies.
 //
-// The container supports both transient and singleton bindings, allowing you to register
+// The container supports transient, singleton and scoped bindings, allowing you to register
 // and resolve dependencies throughout your application. It is thread-safe and can be used
 // concurrently from multiple goroutines.
 //
@@ -59,34 +59,114 @@
 //	        Logger: logger.(*Logger),
 //	    }
 //	})
+//
+// Constructors can also declare their dependencies as parameters, which are
+// resolved by type (or by key through Wire):
+//
+//	c.Singleton("service", func(db *Database, logger *Logger) (*Service, error) {
+//	    return NewService(db, logger)
+//	})
+//
+// # Typed Resolution
+//
+// Generic helpers avoid manual type assertions and report type mismatches as
+// errors instead of panics:
+//
+//	db, err := container.Resolve[*Database](c, "db")
+//
+//	// Type-keyed bindings need no string key at all
+//	container.SingletonType[*Database](c, func(c container.Container) *Database {
+//	    return &Database{Host: "localhost"}
+//	})
+//	db, err := container.ResolveType[*Database](c)
+//
+// # Scopes
+//
+// Scoped bindings are shared within a scope, such as a single HTTP request,
+// and released when the scope is disposed:
+//
+//	c.Scoped("uow", func(db *Database) *UnitOfWork {
+//	    return NewUnitOfWork(db)
+//	})
+//
+//	scope := c.NewScope()
+//	defer scope.Dispose(ctx)
+//	uow, err := scope.Make("uow")
+//
+// Child containers override bindings without mutating their parent, which is
+// useful in tests and isolated modules:
+//
+//	child := c.Child()
+//	child.Instance("mailer", fakeMailer)
+//	notifier, err := child.Make("notifier") // built with fakeMailer
+//
+// # Introspection
+//
+// The container can be inspected without resolving anything, and the
+// dependencies recorded while resolving can be exported for debugging:
+//
+//	if info, ok := c.Binding("db"); ok {
+//	    fmt.Println(info.Lifetime, info.Provider, info.Resolved)
+//	}
+//	container.ExportGraph(os.Stdout, c, container.GraphFormatDOT)
 package container
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"reflect"
 	"sync"
 
 	"github.com/donnigundala/dg-core/contracts/container"
 )
 
+// ErrBindingNotFound is returned by Make when no binding or instance exists for a key.
+var ErrBindingNotFound = errors.New("binding not found")
+
 // Container is the interface for the dependency injection container.
 type Container interface {
 	// Bind registers a binding with the container.
 	Bind(key string, resolver interface{})
 	// Singleton registers a shared binding in the container.
 	Singleton(key string, resolver interface{})
+	// Scoped registers a binding that is shared within a scope.
+	Scoped(key string, resolver interface{})
 	// Instance registers an existing instance as shared in the container.
 	Instance(key string, instance interface{})
 	// Make resolves the given type from the container.
 	Make(key string) (interface{}, error)
+	// Tag assigns a tag to the given keys.
+	Tag(keys []string, tag string)
+	// Tagged resolves every key with the given tag.
+	Tagged(tag string) ([]interface{}, error)
+	// Extend registers a decorator for the instances resolved for a key.
+	Extend(key string, extender func(instance interface{}, c container.Container) interface{})
+	// Resolving registers a callback that runs after an instance for a key is built.
+	Resolving(key string, callback func(instance interface{}, c container.Container))
+	// NewScope creates a new scope for scoped bindings.
+	NewScope() container.Scope
+	// Child creates a container that falls back to this one for missing keys.
+	Child() container.Container
+	// Dispose closes and releases the instances built by the container.
+	Dispose(ctx context.Context) error
+	// Has reports whether a binding or instance exists for a key.
+	Has(key string) bool
+	// Binding returns the metadata of the binding for a key.
+	Binding(key string) (container.BindingInfo, bool)
+	// Keys returns every bound key in sorted order.
+	Keys() []string
+	// Forget removes the binding and instance for a key.
+	Forget(key string)
 	// Flush removes all bindings and instances from the container.
 	Flush()
 }
 
 // binding represents a registered dependency.
 type binding struct {
-	resolver interface{} // The function that resolves the dependency
-	shared   bool        // Whether the instance should be shared (singleton)
+	resolver interface{}        // The function that resolves the dependency
+	lifetime container.Lifetime // How long the resolved instance is shared
+	provider string             // The provider that registered the binding
+	resolved bool               // Whether the binding has been resolved
 }
 
 // container is the concrete implementation of the Container interface.
@@ -94,6 +174,17 @@ type containerImpl struct {
 	mu        sync.RWMutex
 	bindings  map[string]binding
 	instances map[string]interface{}
+	building  map[string]*sync.Mutex
+	tags      map[string][]string
+	extenders map[string][]Extender
+	callbacks map[string][]ResolvingCallback
+	owners    map[string]string    // Providers that registered instances
+	edges     map[string][]string  // Resolved dependencies per key (root only)
+	provider  string               // The provider currently registering bindings
+	deferred  map[string]*deferral // Keys provided by providers not loaded yet
+	resolved  []string             // Keys built from bindings, in creation order
+	parent    *containerImpl       // Fallback for keys not bound here
+	scope     bool                 // Whether this container is a scope
 }
 
 // NewContainer creates a new dependency injection container instance.
@@ -117,9 +208,23 @@ type containerImpl struct {
 //	    log.Fatal(err)
 //	}
 func NewContainer() container.Container {
+	return newContainer(nil, false)
+}
+
+// newContainer creates an empty container below the given parent.
+func newContainer(parent *containerImpl, scope bool) *containerImpl {
 	return &containerImpl{
 		bindings:  make(map[string]binding),
 		instances: make(map[string]interface{}),
+		building:  make(map[string]*sync.Mutex),
+		tags:      make(map[string][]string),
+		extenders: make(map[string][]Extender),
+		callbacks: make(map[string][]ResolvingCallback),
+		owners:    make(map[string]string),
+		deferred:  make(map[string]*deferral),
+		edges:     make(map[string][]string),
+		parent:    parent,
+		scope:     scope,
 	}
 }
 
@@ -142,12 +247,18 @@ func NewContainer() container.Container {
 //	    return &Service{Logger: logger.(*Logger)}
 //	})
 func (c *containerImpl) Bind(key string, resolver interface{}) {
+	c.bind(key, resolver, container.LifetimeTransient, c.currentProvider())
+}
+
+// bind registers a binding attributed to the given provider.
+func (c *containerImpl) bind(key string, resolver interface{}, lifetime container.Lifetime, provider string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	c.bindings[key] = binding{
 		resolver: resolver,
-		shared:   false,
+		lifetime: lifetime,
+		provider: provider,
 	}
 }
 
@@ -171,13 +282,7 @@ func (c *containerImpl) Bind(key string, resolver interface{}) {
 //	db2, _ := c.Make("db")
 //	// db1 == db2 (same pointer)
 func (c *containerImpl) Singleton(key string, resolver interface{}) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	c.bindings[key] = binding{
-		resolver: resolver,
-		shared:   true,
-	}
+	c.bind(key, resolver, container.LifetimeSingleton, c.currentProvider())
 }
 
 // Instance registers an existing instance as shared in the container.
@@ -199,10 +304,16 @@ func (c *containerImpl) Singleton(key string, resolver interface{}) {
 //	cfg, _ := c.Make("config")
 //	// cfg == config (same pointer)
 func (c *containerImpl) Instance(key string, instance interface{}) {
+	c.instance(key, instance, c.currentProvider())
+}
+
+// instance registers an instance attributed to the given provider.
+func (c *containerImpl) instance(key string, instance interface{}, provider string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	c.instances[key] = instance
+	c.owners[key] = provider
 }
 
 // Make resolves the given type from the container.
@@ -221,6 +332,14 @@ func (c *containerImpl) Instance(key string, instance interface{}) {
 //	    return &Service{DB: db, Logger: logger}
 //	})
 //
+// Constructors with typed parameters are auto-wired: each parameter is resolved
+// by its type key (see TypeKey), or by an explicit key when wrapped with Wire.
+// A second error return value is passed back from Make:
+//
+//	c.Singleton("users", func(db *sql.DB, log *slog.Logger) (*UserService, error) {
+//	    return NewUserService(db, log)
+//	})
+//
 // Make returns an error if the binding is not found:
 //
 //	instance, err := c.Make("unknown")
@@ -238,64 +357,173 @@ func (c *containerImpl) Instance(key string, instance interface{}) {
 //	instance, err := c.Make("bad")
 //	// err will contain: "panic while resolving 'bad': something went wrong"
 //
+// Circular Dependencies: Resolvers may call Make re-entrantly on the container
+// they receive. If a key ends up depending on itself, Make returns an error
+// wrapping ErrCircularDependency that lists the whole resolution path:
+//
+//	// err: "circular dependency: a -> b -> a"
+//
+// Resolvers should resolve through the container passed to them rather than
+// a captured one: only that container knows the current path, and it is the
+// container building the instance, so the overrides of a child container
+// apply. A captured container starts a new path, so a cycle through it is not
+// detected and blocks on the singleton being built.
+//
 // Thread Safety: Make is safe for concurrent use. For singleton bindings,
 // double-checked locking ensures only one instance is created even when
 // called concurrently.
-func (c *containerImpl) Make(key string) (instance interface{}, err error) {
+func (c *containerImpl) Make(key string) (interface{}, error) {
+	return c.resolve(key, nil, &chain{})
+}
+
+// resolve resolves key as part of a resolution path. The container mutex is
+// never held while a resolver runs, so resolvers may call Make re-entrantly.
+func (c *containerImpl) resolve(key string, path []string, ch *chain) (instance interface{}, err error) {
 	// Panic recovery - convert panics to errors for production safety
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("panic while resolving '%s': %v", key, r)
+			if cycle := ch.failure(); cycle != nil {
+				// The panic is a consequence of an ignored cycle error
+				err = cycle
+			}
 			instance = nil
 		}
 	}()
 
-	c.mu.RLock()
-	if instance, ok := c.instances[key]; ok {
-		c.mu.RUnlock()
+	// Record the dependency of the key being built
+	if len(path) > 0 {
+		c.root().addEdge(path[len(path)-1], key)
+	}
+
+	for _, inFlight := range path {
+		if inFlight == key {
+			err := circularError(path, key)
+			ch.fail(err)
+			return nil, err
+		}
+	}
+
+	m, ok := c.lookup(key)
+	if !ok {
+		// The key may belong to a provider that has not been loaded yet
+		if loaded, err := c.loadDeferred(key); err != nil {
+			return nil, err
+		} else if loaded {
+			m, ok = c.lookup(key)
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w for key: %s", ErrBindingNotFound, key)
+	}
+	if m.resolved {
+		return m.instance, nil
+	}
+
+	// Pick the container that builds (and for shared lifetimes, caches) the
+	// instance: transients are built where they are requested, singletons
+	// where they are bound (or in the nearest child container) and scoped
+	// bindings in the nearest scope.
+	home := c
+	switch m.binding.lifetime {
+	case container.LifetimeSingleton:
+		home = c.singletonHome(m.owner)
+	case container.LifetimeScoped:
+		if home = c.nearestScope(); home == nil {
+			return nil, fmt.Errorf("%w: %s", ErrOutOfScope, key)
+		}
+	}
+
+	r := &resolution{
+		containerImpl: home,
+		path:          append(path[:len(path):len(path)], key),
+		chain:         ch,
+	}
+
+	// Transient bindings need no synchronization while building.
+	if m.binding.lifetime == container.LifetimeTransient {
+		instance, err = r.build(key, m.binding)
+		if err != nil {
+			return nil, err
+		}
+		m.owner.markResolved(key)
 		return instance, nil
 	}
-	c.mu.RUnlock()
 
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	// Serialize construction per key, so only one instance is ever created
+	lock := home.buildLock(key)
+	lock.Lock()
+	defer lock.Unlock()
 
 	// Double check locking
-	if instance, ok := c.instances[key]; ok {
+	home.mu.RLock()
+	instance, ok = home.instances[key]
+	home.mu.RUnlock()
+	if ok {
 		return instance, nil
 	}
 
-	binding, ok := c.bindings[key]
-	if !ok {
-		return nil, fmt.Errorf("binding not found for key: %s", key)
+	instance, err = r.build(key, m.binding)
+	if err != nil {
+		return nil, err
 	}
 
-	resolverVal := reflect.ValueOf(binding.resolver)
-	if resolverVal.Kind() == reflect.Func {
-		// For simplicity, we assume the resolver function takes no arguments
-		// or we can implement dependency injection here later.
-		// For now, let's assume it takes a Container as argument if it has 1 arg.
-		args := []reflect.Value{}
-		if resolverVal.Type().NumIn() == 1 {
-			// Pass the container itself if the resolver expects it
-			// We need to cast *containerImpl to container.Container
-			args = append(args, reflect.ValueOf(c))
-		}
+	// The container caching the instance reports it as resolved
+	home.mu.Lock()
+	home.instances[key] = instance
+	home.resolved = append(home.resolved, key)
+	home.mu.Unlock()
+
+	return instance, nil
+}
+
+// match is the result of looking up a key in a container hierarchy.
+type match struct {
+	owner    *containerImpl // The container holding the instance or binding
+	instance interface{}
+	resolved bool // Whether instance holds an existing instance
+	binding  binding
+}
+
+// lookup finds an existing instance or a binding for key, starting at c and
+// walking up through its parents.
+func (c *containerImpl) lookup(key string) (match, bool) {
+	for current := c; current != nil; current = current.parent {
+		current.mu.RLock()
+		instance, resolved := current.instances[key]
+		b, bound := current.bindings[key]
+		current.mu.RUnlock()
 
-		results := resolverVal.Call(args)
-		if len(results) == 0 {
-			return nil, fmt.Errorf("resolver returned no results for key: %s", key)
+		if resolved {
+			return match{owner: current, instance: instance, resolved: true}, true
+		}
+		if bound {
+			return match{owner: current, binding: b}, true
 		}
-		instance := results[0].Interface()
+	}
+	return match{}, false
+}
 
-		if binding.shared {
-			c.instances[key] = instance
+// singletonHome returns the container that builds a singleton bound on owner
+// when it is requested from c: the nearest child container below owner, so
+// its overrides apply without touching the parent, or owner itself.
+func (c *containerImpl) singletonHome(owner *containerImpl) *containerImpl {
+	for current := c; current != owner && current != nil; current = current.parent {
+		if !current.scope {
+			return current
 		}
-		return instance, nil
 	}
+	return owner
+}
 
-	// If resolver is not a function, return it as is (though Bind usually expects a function)
-	return binding.resolver, nil
+// nearestScope returns the closest scope container, starting at c itself.
+func (c *containerImpl) nearestScope() *containerImpl {
+	for current := c; current != nil; current = current.parent {
+		if current.scope {
+			return current
+		}
+	}
+	return nil
 }
 
 // Flush removes all bindings and instances from the container.
@@ -323,4 +551,11 @@ func (c *containerImpl) Flush() {
 
 	c.bindings = make(map[string]binding)
 	c.instances = make(map[string]interface{})
+	c.tags = make(map[string][]string)
+	c.extenders = make(map[string][]Extender)
+	c.callbacks = make(map[string][]ResolvingCallback)
+	c.owners = make(map[string]string)
+	c.edges = make(map[string][]string)
+	c.deferred = make(map[string]*deferral)
+	c.resolved = nil
 }
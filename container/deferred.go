@@ -0,0 +1,91 @@
+package container
+
+import (
+	"fmt"
+	"sync"
+)
+
+// deferral loads the bindings of a deferred provider the first time one of
+// its keys is resolved.
+type deferral struct {
+	keys     []string
+	load     func() error
+	provider string
+	once     sync.Once
+	err      error
+}
+
+// Defer registers keys whose bindings are registered by load, which runs
+// only the first time one of the keys is resolved. Until then Has reports
+// the keys as bound and Binding reports them with LifetimeDeferred.
+//
+// The foundation uses this for deferred service providers:
+//
+//	c.Defer([]string{"mailer"}, func() error {
+//	    return registerProvider(mailProvider)
+//	})
+//
+// load runs at most once; concurrent resolutions wait for it to finish and
+// get its error. load must bind the keys without resolving them.
+func (c *containerImpl) Defer(keys []string, load func() error) {
+	c.deferTo(keys, load, c.currentProvider())
+}
+
+// deferTo registers a deferral attributed to the given provider.
+func (c *containerImpl) deferTo(keys []string, load func() error, provider string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d := &deferral{keys: keys, load: load, provider: provider}
+	for _, key := range keys {
+		c.deferred[key] = d
+	}
+}
+
+// deferralFor finds the deferral of key in the container hierarchy.
+func (c *containerImpl) deferralFor(key string) (*containerImpl, *deferral) {
+	for current := c; current != nil; current = current.parent {
+		current.mu.RLock()
+		d, ok := current.deferred[key]
+		current.mu.RUnlock()
+		if ok {
+			return current, d
+		}
+	}
+	return nil, nil
+}
+
+// LoadDeferred runs the deferral of key, if it has one that has not run yet,
+// without resolving the key. The console kernel uses it to load deferred
+// providers that add commands.
+func (c *containerImpl) LoadDeferred(key string) error {
+	_, err := c.loadDeferred(key)
+	return err
+}
+
+// loadDeferred runs the deferral of key, if any, and reports whether one was
+// found.
+func (c *containerImpl) loadDeferred(key string) (bool, error) {
+	owner, d := c.deferralFor(key)
+	if d == nil {
+		return false, nil
+	}
+
+	d.once.Do(func() {
+		if err := d.load(); err != nil {
+			// Keep the deferral, so every resolution reports the failure
+			d.err = fmt.Errorf("failed to load deferred provider for '%s': %w", key, err)
+			return
+		}
+
+		owner.mu.Lock()
+		for _, k := range d.keys {
+			if owner.deferred[k] == d {
+				delete(owner.deferred, k)
+			}
+		}
+		owner.mu.Unlock()
+	})
+
+	return true, d.err
+}
@@ -0,0 +1,85 @@
+package container_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+	contractContainer "github.com/donnigundala/dg-core/contracts/container"
+)
+
+// deferrer is implemented by the container for deferred providers
+type deferrer interface {
+	Defer(keys []string, load func() error)
+}
+
+// TestDefer_LoadsOnFirstResolve tests that a deferral runs only when a key is resolved
+func TestDefer_LoadsOnFirstResolve(t *testing.T) {
+	c := container.NewContainer()
+	var loads int32
+	c.(deferrer).Defer([]string{"mailer", "mail.queue"}, func() error {
+		atomic.AddInt32(&loads, 1)
+		c.Singleton("mailer", func() interface{} { return "mailer" })
+		c.Singleton("mail.queue", func() interface{} { return "queue" })
+		return nil
+	})
+
+	if !c.Has("mailer") {
+		t.Error("Expected deferred key to be reported as bound")
+	}
+	if info, _ := c.Binding("mailer"); info.Lifetime != contractContainer.LifetimeDeferred {
+		t.Errorf("Expected deferred lifetime, got %s", info.Lifetime)
+	}
+	if atomic.LoadInt32(&loads) != 0 {
+		t.Fatal("Expected deferral not to run before resolving")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if mailer, err := c.Make("mailer"); err != nil || mailer != "mailer" {
+				t.Errorf("Expected mailer, got %v (%v)", mailer, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if queue, err := c.NewScope().Make("mail.queue"); err != nil || queue != "queue" {
+		t.Errorf("Expected queue, got %v (%v)", queue, err)
+	}
+	if atomic.LoadInt32(&loads) != 1 {
+		t.Errorf("Expected deferral to run once, ran %d times", loads)
+	}
+	if info, _ := c.Binding("mailer"); info.Lifetime != contractContainer.LifetimeSingleton {
+		t.Errorf("Expected singleton lifetime after loading, got %s", info.Lifetime)
+	}
+}
+
+// TestDefer_LoadError tests that a failing deferral is reported on every resolution
+func TestDefer_LoadError(t *testing.T) {
+	c := container.NewContainer()
+	loadErr := errors.New("smtp not configured")
+	c.(deferrer).Defer([]string{"mailer"}, func() error { return loadErr })
+
+	for i := 0; i < 2; i++ {
+		_, err := c.Make("mailer")
+		if !errors.Is(err, loadErr) {
+			t.Fatalf("Expected load error, got %v", err)
+		}
+	}
+}
+
+// TestDefer_UnboundKey tests resolving a provided key that the deferral did not bind
+func TestDefer_UnboundKey(t *testing.T) {
+	c := container.NewContainer()
+	c.(deferrer).Defer([]string{"mailer"}, func() error { return nil })
+
+	_, err := c.Make("mailer")
+	if !errors.Is(err, container.ErrBindingNotFound) {
+		t.Errorf("Expected ErrBindingNotFound, got %v", err)
+	}
+}
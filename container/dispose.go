@@ -0,0 +1,90 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// Shutdowner is implemented by instances that need a context to shut down,
+// such as servers or message clients.
+type Shutdowner interface {
+	Shutdown(ctx context.Context) error
+}
+
+// Dispose releases every instance the container built from its bindings
+// (singletons, or the scoped instances of a scope) in reverse creation order.
+//
+// Instances implementing Shutdowner or io.Closer are shut down or closed on
+// the way, so resources like database pools don't need a hand-written
+// ShutdownProvider:
+//
+//	c.Singleton("db", func() (*sql.DB, error) {
+//	    return sql.Open("postgres", dsn)
+//	})
+//
+//	// Later, during shutdown: db.Close() is called
+//	err := c.Dispose(ctx)
+//
+// Instances registered with Instance are left untouched, as they are owned by
+// the caller; transient instances are never tracked. All errors are
+// aggregated. If ctx is done before every instance has been disposed, the
+// remaining ones are skipped and the context error is included.
+func (c *containerImpl) Dispose(ctx context.Context) error {
+	c.mu.Lock()
+	keys := c.resolved
+	instances := make([]interface{}, len(keys))
+	for i, key := range keys {
+		instances[i] = c.instances[key]
+		delete(c.instances, key)
+	}
+	c.resolved = nil
+	c.mu.Unlock()
+
+	var errs []error
+	disposed := make(map[interface{}]bool)
+	for i := len(keys) - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, fmt.Errorf("dispose aborted with %d instance(s) left: %w", i+1, err))
+			break
+		}
+
+		instance := instances[i]
+		if instance == nil {
+			continue
+		}
+
+		// The same instance may be bound under several keys
+		if reflect.TypeOf(instance).Comparable() {
+			if disposed[instance] {
+				continue
+			}
+			disposed[instance] = true
+		}
+
+		if err := disposeInstance(ctx, instance); err != nil {
+			errs = append(errs, fmt.Errorf("failed to dispose '%s': %w", keys[i], err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// disposeInstance shuts down or closes a single instance, recovering from panics.
+func disposeInstance(ctx context.Context, instance interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while disposing: %v", r)
+		}
+	}()
+
+	switch v := instance.(type) {
+	case Shutdowner:
+		return v.Shutdown(ctx)
+	case io.Closer:
+		return v.Close()
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package container_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+)
+
+type closer struct {
+	name   string
+	order  *[]string
+	err    error
+	closed int
+}
+
+func (c *closer) Close() error {
+	c.closed++
+	*c.order = append(*c.order, c.name)
+	return c.err
+}
+
+type shutdowner struct {
+	closer
+	ctx context.Context
+}
+
+func (s *shutdowner) Shutdown(ctx context.Context) error {
+	s.ctx = ctx
+	*s.order = append(*s.order, s.name)
+	return s.err
+}
+
+// TestDispose_ReverseOrder tests that singletons are closed in reverse creation order
+func TestDispose_ReverseOrder(t *testing.T) {
+	c := container.NewContainer()
+	var order []string
+
+	c.Singleton("db", func() interface{} { return &closer{name: "db", order: &order} })
+	c.Singleton("queue", func() interface{} { return &shutdowner{closer: closer{name: "queue", order: &order}} })
+	c.Singleton("cache", func() interface{} { return &closer{name: "cache", order: &order} })
+
+	// Creation order: db, cache, queue
+	for _, key := range []string{"db", "cache", "queue"} {
+		if _, err := c.Make(key); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	ctx := context.Background()
+	if err := c.Dispose(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"queue", "cache", "db"}
+	if len(order) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, order)
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, order)
+			break
+		}
+	}
+}
+
+// TestDispose_SkipsInstancesAndTransients tests that only built shared instances are disposed
+func TestDispose_SkipsInstancesAndTransients(t *testing.T) {
+	c := container.NewContainer()
+	var order []string
+
+	registered := &closer{name: "registered", order: &order}
+	c.Instance("registered", registered)
+	c.Bind("transient", func() interface{} { return &closer{name: "transient", order: &order} })
+	_, _ = c.Make("transient")
+
+	if err := c.Dispose(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(order) != 0 {
+		t.Errorf("Expected nothing to be closed, got %v", order)
+	}
+	if instance, _ := c.Make("registered"); instance != registered {
+		t.Error("Expected registered instance to remain bound")
+	}
+}
+
+// TestDispose_AggregatesErrors tests that all disposal errors are reported
+func TestDispose_AggregatesErrors(t *testing.T) {
+	c := container.NewContainer()
+	var order []string
+	errDB := errors.New("db close failed")
+	errCache := errors.New("cache close failed")
+
+	c.Singleton("db", func() interface{} { return &closer{name: "db", order: &order, err: errDB} })
+	c.Singleton("cache", func() interface{} { return &closer{name: "cache", order: &order, err: errCache} })
+	_, _ = c.Make("db")
+	_, _ = c.Make("cache")
+
+	err := c.Dispose(context.Background())
+	if !errors.Is(err, errDB) || !errors.Is(err, errCache) {
+		t.Fatalf("Expected both errors, got %v", err)
+	}
+	if !contains(err.Error(), "failed to dispose 'db'") {
+		t.Errorf("Expected error to name the key, got %v", err)
+	}
+	if len(order) != 2 {
+		t.Errorf("Expected every instance to be closed, got %v", order)
+	}
+}
+
+// TestDispose_SharedInstanceOnce tests that an instance bound twice is closed once
+func TestDispose_SharedInstanceOnce(t *testing.T) {
+	c := container.NewContainer()
+	var order []string
+	db := &closer{name: "db", order: &order}
+
+	c.Singleton("db", func() interface{} { return db })
+	c.Singleton("db.alias", func() interface{} { return db })
+	_, _ = c.Make("db")
+	_, _ = c.Make("db.alias")
+
+	_ = c.Dispose(context.Background())
+	if db.closed != 1 {
+		t.Errorf("Expected instance to be closed once, got %d", db.closed)
+	}
+}
+
+// TestDispose_ContextCanceled tests that disposal stops when the context is done
+func TestDispose_ContextCanceled(t *testing.T) {
+	c := container.NewContainer()
+	var order []string
+	c.Singleton("db", func() interface{} { return &closer{name: "db", order: &order} })
+	_, _ = c.Make("db")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := c.Dispose(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context error, got %v", err)
+	}
+}
+
+// TestDispose_ScopeClosesScopedInstances tests that disposing a scope closes its instances only
+func TestDispose_ScopeClosesScopedInstances(t *testing.T) {
+	c := container.NewContainer()
+	var order []string
+
+	c.Singleton("db", func() interface{} { return &closer{name: "db", order: &order} })
+	c.Scoped("uow", func(c container.Container) interface{} {
+		_, _ = c.Make("db")
+		return &closer{name: "uow", order: &order}
+	})
+
+	scope := c.NewScope()
+	if _, err := scope.Make("uow"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if err := scope.Dispose(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(order) != 1 || order[0] != "uow" {
+		t.Errorf("Expected only the scoped instance to be closed, got %v", order)
+	}
+}
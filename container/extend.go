@@ -0,0 +1,86 @@
+package container
+
+import (
+	"github.com/donnigundala/dg-core/contracts/container"
+)
+
+// Extender decorates a resolved instance, returning the instance to use instead.
+type Extender = func(instance interface{}, c container.Container) interface{}
+
+// ResolvingCallback is called with every instance built for a key.
+type ResolvingCallback = func(instance interface{}, c container.Container)
+
+// Extend registers a decorator for the given key, allowing services
+// registered by other providers to be wrapped without replacing them:
+//
+//	c.Extend("users.repository", func(instance interface{}, c container.Container) interface{} {
+//	    return NewCachedUserRepository(instance.(UserRepository))
+//	})
+//
+// Extenders run in registration order every time a transient binding is built
+// and once for a singleton. If the key has already been resolved to a shared
+// instance in this container, that instance is extended immediately.
+func (c *containerImpl) Extend(key string, extender func(instance interface{}, c container.Container) interface{}) {
+	c.mu.Lock()
+	c.extenders[key] = append(c.extenders[key], extender)
+	instance, resolved := c.instances[key]
+	c.mu.Unlock()
+
+	if !resolved {
+		return
+	}
+
+	// Run the extender without holding the lock, it may resolve other keys
+	r := &resolution{containerImpl: c, path: []string{key}, chain: &chain{}}
+	extended := extender(instance, r)
+
+	c.mu.Lock()
+	c.instances[key] = extended
+	c.mu.Unlock()
+}
+
+// Resolving registers a callback that runs after an instance for the given
+// key has been built (and extended), for example to attach observers or
+// validate configuration:
+//
+//	c.Resolving("mailer", func(instance interface{}, c container.Container) {
+//	    instance.(*Mailer).SetLogger(logger)
+//	})
+//
+// Callbacks run every time a transient binding is built and once for a
+// singleton. They are not called for instances registered with Instance.
+func (c *containerImpl) Resolving(key string, callback func(instance interface{}, c container.Container)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.callbacks[key] = append(c.callbacks[key], callback)
+}
+
+// decorate applies the extenders and resolving callbacks registered for key
+// on the building container and its parents, starting at the root.
+func (r *resolution) decorate(key string, instance interface{}) interface{} {
+	extenders, callbacks := r.hooks(key)
+
+	for _, extend := range extenders {
+		instance = extend(instance, r)
+	}
+	for _, callback := range callbacks {
+		callback(instance, r)
+	}
+	return instance
+}
+
+// hooks collects the extenders and resolving callbacks for key, root first.
+func (c *containerImpl) hooks(key string) ([]Extender, []ResolvingCallback) {
+	var extenders []Extender
+	var callbacks []ResolvingCallback
+	if c.parent != nil {
+		extenders, callbacks = c.parent.hooks(key)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	extenders = append(extenders, c.extenders[key]...)
+	callbacks = append(callbacks, c.callbacks[key]...)
+	return extenders, callbacks
+}
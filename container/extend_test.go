@@ -0,0 +1,143 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+	contractContainer "github.com/donnigundala/dg-core/contracts/container"
+)
+
+type repository interface {
+	Find() string
+}
+
+type dbRepository struct{}
+
+func (dbRepository) Find() string { return "db" }
+
+type cachedRepository struct {
+	inner repository
+}
+
+func (r cachedRepository) Find() string { return "cached(" + r.inner.Find() + ")" }
+
+// TestExtend_Transient tests that extenders wrap every transient instance
+func TestExtend_Transient(t *testing.T) {
+	c := container.NewContainer()
+	c.Bind("repo", func() interface{} { return dbRepository{} })
+	c.Extend("repo", func(instance interface{}, c contractContainer.Container) interface{} {
+		return cachedRepository{inner: instance.(repository)}
+	})
+
+	for i := 0; i < 2; i++ {
+		repo, err := container.Resolve[repository](c, "repo")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if repo.Find() != "cached(db)" {
+			t.Errorf("Expected 'cached(db)', got %s", repo.Find())
+		}
+	}
+}
+
+// TestExtend_SingletonOnce tests that extenders run once for singletons, in order
+func TestExtend_SingletonOnce(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("repo", func() interface{} { return dbRepository{} })
+
+	calls := 0
+	c.Extend("repo", func(instance interface{}, c contractContainer.Container) interface{} {
+		calls++
+		return cachedRepository{inner: instance.(repository)}
+	})
+	c.Extend("repo", func(instance interface{}, c contractContainer.Container) interface{} {
+		return cachedRepository{inner: instance.(repository)}
+	})
+
+	first, _ := container.Resolve[repository](c, "repo")
+	second, _ := container.Resolve[repository](c, "repo")
+
+	if first.Find() != "cached(cached(db))" {
+		t.Errorf("Expected extenders in registration order, got %s", first.Find())
+	}
+	if first != second || calls != 1 {
+		t.Errorf("Expected singleton to be extended once, got %d calls", calls)
+	}
+}
+
+// TestExtend_AlreadyResolved tests that resolved instances are extended immediately
+func TestExtend_AlreadyResolved(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("repo", dbRepository{})
+
+	c.Extend("repo", func(instance interface{}, c contractContainer.Container) interface{} {
+		return cachedRepository{inner: instance.(repository)}
+	})
+
+	repo, _ := container.Resolve[repository](c, "repo")
+	if repo.Find() != "cached(db)" {
+		t.Errorf("Expected existing instance to be extended, got %s", repo.Find())
+	}
+}
+
+// TestExtend_ResolvesDependencies tests that extenders can use the container
+func TestExtend_ResolvesDependencies(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("prefix", "tenant-")
+	c.Bind("name", func() interface{} { return "acme" })
+	c.Extend("name", func(instance interface{}, c contractContainer.Container) interface{} {
+		prefix, _ := c.Make("prefix")
+		return prefix.(string) + instance.(string)
+	})
+
+	name, err := c.Make("name")
+	if err != nil || name != "tenant-acme" {
+		t.Errorf("Expected 'tenant-acme', got %v (%v)", name, err)
+	}
+}
+
+// TestResolving_Callbacks tests resolving callbacks for transient and singleton bindings
+func TestResolving_Callbacks(t *testing.T) {
+	c := container.NewContainer()
+	c.Bind("transient", func() interface{} { return &genericDatabase{} })
+	c.Singleton("singleton", func() interface{} { return &genericDatabase{} })
+
+	var seen []interface{}
+	callback := func(instance interface{}, c contractContainer.Container) {
+		instance.(*genericDatabase).Host = "configured"
+		seen = append(seen, instance)
+	}
+	c.Resolving("transient", callback)
+	c.Resolving("singleton", callback)
+
+	t1, _ := container.Resolve[*genericDatabase](c, "transient")
+	_, _ = c.Make("transient")
+	s1, _ := container.Resolve[*genericDatabase](c, "singleton")
+	_, _ = c.Make("singleton")
+
+	if len(seen) != 3 {
+		t.Errorf("Expected 3 callbacks (2 transient, 1 singleton), got %d", len(seen))
+	}
+	if t1.Host != "configured" || s1.Host != "configured" {
+		t.Error("Expected callbacks to see the built instance")
+	}
+}
+
+// TestResolving_AfterExtend tests that callbacks receive the extended instance
+func TestResolving_AfterExtend(t *testing.T) {
+	c := container.NewContainer()
+	c.Bind("repo", func() interface{} { return dbRepository{} })
+	c.Extend("repo", func(instance interface{}, c contractContainer.Container) interface{} {
+		return cachedRepository{inner: instance.(repository)}
+	})
+
+	var got interface{}
+	c.Resolving("repo", func(instance interface{}, c contractContainer.Container) {
+		got = instance
+	})
+
+	_, _ = c.Make("repo")
+	if _, ok := got.(cachedRepository); !ok {
+		t.Errorf("Expected callback to receive the extended instance, got %T", got)
+	}
+}
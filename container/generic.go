@@ -0,0 +1,138 @@
+package container
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/donnigundala/dg-core/contracts/container"
+)
+
+// TypeKey returns the container key used for type-keyed bindings of T.
+//
+// The key is derived from the fully qualified type name, so two types with the
+// same name in different packages never collide:
+//
+//	container.TypeKey[*Database]() // "*github.com/acme/app/db.Database"
+func TypeKey[T any]() string {
+	return typeKey(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// typeKey builds a stable, package-qualified key for the given type.
+func typeKey(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		return "*" + typeKey(t.Elem())
+	}
+	if t.Name() != "" && t.PkgPath() != "" {
+		return t.PkgPath() + "." + t.Name()
+	}
+	return t.String()
+}
+
+// Resolve resolves the given key from the container and asserts it to T.
+//
+// Instead of panicking on a failed type assertion, Resolve returns an error
+// naming both the expected and the actual type:
+//
+//	db, err := container.Resolve[*Database](app, "db")
+//	if err != nil {
+//	    // e.g. "type mismatch resolving 'db': expected *db.Database, got *db.Pool"
+//	}
+//
+// Resolve works with any container.Container, including foundation.Application.
+func Resolve[T any](c container.Container, key string) (T, error) {
+	var zero T
+
+	instance, err := c.Make(key)
+	if err != nil {
+		return zero, err
+	}
+
+	return assertType[T](key, instance)
+}
+
+// MustResolve is like Resolve but panics if the key cannot be resolved or the
+// resolved instance is not of type T.
+//
+// It is intended for bootstrapping code where a missing binding is a
+// programming error:
+//
+//	logger := container.MustResolve[*slog.Logger](app, "log")
+func MustResolve[T any](c container.Container, key string) T {
+	instance, err := Resolve[T](c, key)
+	if err != nil {
+		panic(err)
+	}
+	return instance
+}
+
+// ResolveType resolves the type-keyed binding registered for T.
+//
+// Example:
+//
+//	container.SingletonType[*Database](c, func(c container.Container) *Database {
+//	    return &Database{Host: "localhost"}
+//	})
+//
+//	db, err := container.ResolveType[*Database](c)
+func ResolveType[T any](c container.Container) (T, error) {
+	return Resolve[T](c, TypeKey[T]())
+}
+
+// MustResolveType is like ResolveType but panics on error.
+func MustResolveType[T any](c container.Container) T {
+	return MustResolve[T](c, TypeKey[T]())
+}
+
+// BindType registers a transient binding keyed by the type T.
+//
+// The resolver is statically typed, so a change of the bound type is caught
+// by the compiler rather than at resolution time:
+//
+//	container.BindType[*Logger](c, func(c container.Container) *Logger {
+//	    return &Logger{Level: "debug"}
+//	})
+func BindType[T any](c container.Container, resolver func(c container.Container) T) {
+	c.Bind(TypeKey[T](), resolver)
+}
+
+// SingletonType registers a shared binding keyed by the type T.
+//
+// Example:
+//
+//	container.SingletonType[*Database](c, func(c container.Container) *Database {
+//	    return &Database{Host: "localhost"}
+//	})
+func SingletonType[T any](c container.Container, resolver func(c container.Container) T) {
+	c.Singleton(TypeKey[T](), resolver)
+}
+
+// InstanceType registers an existing instance keyed by the type T.
+//
+// Example:
+//
+//	container.InstanceType[*Config](c, &Config{Port: 8080})
+func InstanceType[T any](c container.Container, instance T) {
+	c.Instance(TypeKey[T](), instance)
+}
+
+// assertType converts a resolved instance to T, returning a descriptive
+// error instead of panicking when the types do not match.
+func assertType[T any](key string, instance interface{}) (T, error) {
+	var zero T
+
+	if typed, ok := instance.(T); ok {
+		return typed, nil
+	}
+
+	expected := reflect.TypeOf((*T)(nil)).Elem()
+	if instance == nil {
+		// A nil instance is a valid value for nillable types.
+		switch expected.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			return zero, nil
+		}
+		return zero, fmt.Errorf("type mismatch resolving '%s': expected %s, got nil", key, expected)
+	}
+
+	return zero, fmt.Errorf("type mismatch resolving '%s': expected %s, got %T", key, expected, instance)
+}
@@ -0,0 +1,206 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+	contractContainer "github.com/donnigundala/dg-core/contracts/container"
+	"github.com/donnigundala/dg-core/foundation"
+)
+
+type genericDatabase struct {
+	Host string
+}
+
+type genericPool struct{}
+
+type genericGreeter interface {
+	Greet() string
+}
+
+type englishGreeter struct{}
+
+func (englishGreeter) Greet() string { return "hello" }
+
+// TestResolve_Typed tests resolving a key into a concrete type
+func TestResolve_Typed(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("db", func() interface{} {
+		return &genericDatabase{Host: "localhost"}
+	})
+
+	db, err := container.Resolve[*genericDatabase](c, "db")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if db.Host != "localhost" {
+		t.Errorf("Expected host 'localhost', got %s", db.Host)
+	}
+}
+
+// TestResolve_TypeMismatch tests that a wrong type yields a descriptive error
+func TestResolve_TypeMismatch(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("db", &genericPool{})
+
+	_, err := container.Resolve[*genericDatabase](c, "db")
+	if err == nil {
+		t.Fatal("Expected type mismatch error, got nil")
+	}
+
+	expectedSubstrings := []string{"db", "*container_test.genericDatabase", "*container_test.genericPool"}
+	for _, substr := range expectedSubstrings {
+		if !contains(err.Error(), substr) {
+			t.Errorf("Expected error to contain '%s', got: %s", substr, err.Error())
+		}
+	}
+}
+
+// TestResolve_NotFound tests that Make errors are passed through
+func TestResolve_NotFound(t *testing.T) {
+	c := container.NewContainer()
+
+	_, err := container.Resolve[string](c, "missing")
+	if err == nil || err.Error() != "binding not found for key: missing" {
+		t.Errorf("Expected binding not found error, got %v", err)
+	}
+}
+
+// TestResolve_Interface tests resolving into an interface type
+func TestResolve_Interface(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("greeter", englishGreeter{})
+
+	greeter, err := container.Resolve[genericGreeter](c, "greeter")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if greeter.Greet() != "hello" {
+		t.Errorf("Expected 'hello', got %s", greeter.Greet())
+	}
+}
+
+// TestResolve_NilInstance tests that nil resolves to the zero value of nillable types
+func TestResolve_NilInstance(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("nil", nil)
+
+	db, err := container.Resolve[*genericDatabase](c, "nil")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if db != nil {
+		t.Errorf("Expected nil, got %v", db)
+	}
+
+	if _, err := container.Resolve[int](c, "nil"); err == nil {
+		t.Error("Expected error resolving nil into int")
+	}
+}
+
+// TestMustResolve_Panics tests that MustResolve panics on type mismatch
+func TestMustResolve_Panics(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("value", 42)
+
+	if got := container.MustResolve[int](c, "value"); got != 42 {
+		t.Errorf("Expected 42, got %d", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected MustResolve to panic")
+		}
+	}()
+	container.MustResolve[string](c, "value")
+}
+
+// TestBindType_Transient tests type-keyed transient bindings
+func TestBindType_Transient(t *testing.T) {
+	c := container.NewContainer()
+
+	counter := 0
+	container.BindType[*genericDatabase](c, func(c contractContainer.Container) *genericDatabase {
+		counter++
+		return &genericDatabase{}
+	})
+
+	db1 := container.MustResolveType[*genericDatabase](c)
+	db2 := container.MustResolveType[*genericDatabase](c)
+
+	if db1 == db2 {
+		t.Error("Expected different instances for transient type binding")
+	}
+	if counter != 2 {
+		t.Errorf("Expected counter to be 2, got %d", counter)
+	}
+}
+
+// TestSingletonType_Shared tests type-keyed singleton bindings
+func TestSingletonType_Shared(t *testing.T) {
+	c := container.NewContainer()
+
+	container.SingletonType[*genericDatabase](c, func(c contractContainer.Container) *genericDatabase {
+		return &genericDatabase{Host: "db"}
+	})
+
+	db1, err := container.ResolveType[*genericDatabase](c)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	db2, _ := container.ResolveType[*genericDatabase](c)
+
+	if db1 != db2 {
+		t.Error("Expected same instance for singleton type binding")
+	}
+
+	// The type key is also usable with the untyped API.
+	if _, err := c.Make(container.TypeKey[*genericDatabase]()); err != nil {
+		t.Errorf("Expected type key to be resolvable with Make, got %v", err)
+	}
+}
+
+// TestInstanceType tests registering an instance by type
+func TestInstanceType(t *testing.T) {
+	c := container.NewContainer()
+	db := &genericDatabase{Host: "instance"}
+	container.InstanceType(c, db)
+
+	resolved := container.MustResolveType[*genericDatabase](c)
+	if resolved != db {
+		t.Error("Expected the registered instance")
+	}
+}
+
+// TestTypeKey_Qualified tests that type keys are package qualified
+func TestTypeKey_Qualified(t *testing.T) {
+	expected := "*github.com/donnigundala/dg-core/container_test.genericDatabase"
+	if key := container.TypeKey[*genericDatabase](); key != expected {
+		t.Errorf("Expected key '%s', got '%s'", expected, key)
+	}
+	if key := container.TypeKey[string](); key != "string" {
+		t.Errorf("Expected key 'string', got '%s'", key)
+	}
+}
+
+// TestResolve_Application tests the generic helpers against foundation.Application
+func TestResolve_Application(t *testing.T) {
+	app := foundation.New("/tmp")
+
+	container.SingletonType[*genericDatabase](app, func(c contractContainer.Container) *genericDatabase {
+		return &genericDatabase{Host: "app"}
+	})
+
+	db, err := container.ResolveType[*genericDatabase](app)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if db.Host != "app" {
+		t.Errorf("Expected host 'app', got %s", db.Host)
+	}
+
+	self, err := container.Resolve[*foundation.Application](app, "app")
+	if err != nil || self != app {
+		t.Errorf("Expected to resolve the application itself, got %v, %v", self, err)
+	}
+}
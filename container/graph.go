@@ -0,0 +1,101 @@
+package container
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/donnigundala/dg-core/contracts/container"
+)
+
+// Supported formats for ExportGraph.
+const (
+	GraphFormatDOT  = "dot"
+	GraphFormatJSON = "json"
+)
+
+// Graph is the dependency graph of a container, as recorded while resolving.
+type Graph struct {
+	Nodes []container.BindingInfo `json:"nodes"`
+	Edges []GraphEdge             `json:"edges"`
+}
+
+// GraphEdge is a dependency from one key to another.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// BuildGraph collects every binding of the container and the dependencies
+// recorded while resolving them. Only keys that have been resolved (directly
+// or as a dependency) have edges.
+func BuildGraph(c container.Container) Graph {
+	graph := Graph{
+		Nodes: make([]container.BindingInfo, 0),
+		Edges: make([]GraphEdge, 0),
+	}
+
+	for _, key := range c.Keys() {
+		info, ok := c.Binding(key)
+		if !ok {
+			continue
+		}
+		graph.Nodes = append(graph.Nodes, info)
+		for _, dep := range info.Dependencies {
+			graph.Edges = append(graph.Edges, GraphEdge{From: key, To: dep})
+		}
+	}
+
+	return graph
+}
+
+// ExportGraph writes the resolved dependency graph of the container in the
+// given format (GraphFormatDOT or GraphFormatJSON), which helps debugging
+// large applications:
+//
+//	f, _ := os.Create("container.dot")
+//	defer f.Close()
+//	container.ExportGraph(f, app, container.GraphFormatDOT)
+//
+//	// dot -Tsvg container.dot > container.svg
+func ExportGraph(w io.Writer, c container.Container, format string) error {
+	graph := BuildGraph(c)
+
+	switch format {
+	case GraphFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(graph)
+	case GraphFormatDOT:
+		return writeDOT(w, graph)
+	default:
+		return fmt.Errorf("unsupported graph format: %s", format)
+	}
+}
+
+// writeDOT renders the graph in the Graphviz DOT language.
+func writeDOT(w io.Writer, graph Graph) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "digraph container {")
+	fmt.Fprintln(bw, "  node [shape=box];")
+	for _, node := range graph.Nodes {
+		label := node.Key + "\n" + string(node.Lifetime)
+		if node.Provider != "" {
+			label += "\n" + node.Provider
+		}
+		style := "dashed"
+		if node.Resolved {
+			style = "solid"
+		}
+		fmt.Fprintf(bw, "  %s [label=%s, style=%s];\n", strconv.Quote(node.Key), strconv.Quote(label), style)
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(bw, "  %s -> %s;\n", strconv.Quote(edge.From), strconv.Quote(edge.To))
+	}
+	fmt.Fprintln(bw, "}")
+
+	return bw.Flush()
+}
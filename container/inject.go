@@ -0,0 +1,108 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/donnigundala/dg-core/contracts/container"
+)
+
+// Fill resolves every struct field tagged with `inject` from the container.
+//
+// The tag value is the container key to resolve. An empty tag resolves the
+// field by its type key (see TypeKey). Adding ",optional" skips fields whose
+// binding does not exist instead of failing:
+//
+//	type UserHandler struct {
+//	    DB     *sql.DB      `inject:"db"`
+//	    Logger *slog.Logger `inject:""`
+//	    Cache  Cache        `inject:"cache,optional"`
+//	}
+//
+//	handler := &UserHandler{}
+//	if err := container.Fill(app, handler); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Fields that already hold a non-zero value are left untouched, so values
+// set explicitly are never overwritten. Tagged fields must be exported.
+func Fill(c container.Container, target interface{}) error {
+	return fill(c, target, false)
+}
+
+// FillAvailable is like Fill but silently skips every field whose binding is
+// not registered yet.
+//
+// It is used to inject dependencies into service providers before Register,
+// when bindings of providers registered later are not available yet.
+func FillAvailable(c container.Container, target interface{}) error {
+	return fill(c, target, true)
+}
+
+// fill implements Fill and FillAvailable.
+func fill(c container.Container, target interface{}, skipMissing bool) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("fill target must be a non-nil pointer to a struct, got %T", target)
+	}
+
+	v = v.Elem()
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		fieldType := t.Field(i)
+
+		tag, ok := fieldType.Tag.Lookup("inject")
+		if !ok {
+			continue
+		}
+
+		if !field.CanSet() {
+			return fmt.Errorf("field %s is not settable (unexported)", fieldType.Name)
+		}
+
+		// Never overwrite explicitly set values
+		if !field.IsZero() {
+			continue
+		}
+
+		key, optional := parseInjectTag(tag)
+		if key == "" {
+			key = typeKey(fieldType.Type)
+		}
+
+		instance, err := c.Make(key)
+		if err != nil {
+			if (optional || skipMissing) && errors.Is(err, ErrBindingNotFound) {
+				continue
+			}
+			return fmt.Errorf("failed to inject '%s' into %s: %w", key, fieldType.Name, err)
+		}
+
+		if instance == nil {
+			continue
+		}
+
+		value := reflect.ValueOf(instance)
+		if !value.Type().AssignableTo(fieldType.Type) {
+			return fmt.Errorf("failed to inject '%s' into %s: expected %s, got %T",
+				key, fieldType.Name, fieldType.Type, instance)
+		}
+		field.Set(value)
+	}
+
+	return nil
+}
+
+// parseInjectTag splits an inject tag into its key and the optional flag.
+func parseInjectTag(tag string) (key string, optional bool) {
+	parts := strings.Split(tag, ",")
+	for _, opt := range parts[1:] {
+		if strings.TrimSpace(opt) == "optional" {
+			optional = true
+		}
+	}
+	return strings.TrimSpace(parts[0]), optional
+}
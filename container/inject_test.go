@@ -0,0 +1,111 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+)
+
+type injectHandler struct {
+	DB     *genericDatabase `inject:"db"`
+	Logger *wireLogger      `inject:""`
+	Cache  interface{}      `inject:"cache,optional"`
+	Name   string
+}
+
+// TestFill_ByKeyAndType tests filling fields by key and by type
+func TestFill_ByKeyAndType(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("db", &genericDatabase{Host: "localhost"})
+	container.InstanceType(c, &wireLogger{Prefix: "app"})
+
+	handler := &injectHandler{Name: "users"}
+	if err := container.Fill(c, handler); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if handler.DB == nil || handler.DB.Host != "localhost" {
+		t.Errorf("Expected DB to be injected, got %v", handler.DB)
+	}
+	if handler.Logger == nil || handler.Logger.Prefix != "app" {
+		t.Errorf("Expected Logger to be injected by type, got %v", handler.Logger)
+	}
+	if handler.Cache != nil {
+		t.Errorf("Expected optional Cache to be skipped, got %v", handler.Cache)
+	}
+	if handler.Name != "users" {
+		t.Errorf("Expected untagged field to be untouched, got %s", handler.Name)
+	}
+}
+
+// TestFill_MissingBinding tests that required fields fail when not bound
+func TestFill_MissingBinding(t *testing.T) {
+	c := container.NewContainer()
+	container.InstanceType(c, &wireLogger{})
+
+	err := container.Fill(c, &injectHandler{})
+	if err == nil || !contains(err.Error(), "failed to inject 'db' into DB") {
+		t.Errorf("Expected injection error, got %v", err)
+	}
+}
+
+// TestFill_KeepsExistingValues tests that non-zero fields are not overwritten
+func TestFill_KeepsExistingValues(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("db", &genericDatabase{Host: "container"})
+	container.InstanceType(c, &wireLogger{})
+
+	explicit := &genericDatabase{Host: "explicit"}
+	handler := &injectHandler{DB: explicit}
+	if err := container.Fill(c, handler); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if handler.DB != explicit {
+		t.Error("Expected explicitly set field to be preserved")
+	}
+}
+
+// TestFill_TypeMismatch tests the error for an incompatible bound value
+func TestFill_TypeMismatch(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("db", "not a database")
+	container.InstanceType(c, &wireLogger{})
+
+	err := container.Fill(c, &injectHandler{})
+	if err == nil || !contains(err.Error(), "expected *container_test.genericDatabase, got string") {
+		t.Errorf("Expected type mismatch error, got %v", err)
+	}
+}
+
+// TestFill_InvalidTarget tests that non-pointer targets are rejected
+func TestFill_InvalidTarget(t *testing.T) {
+	c := container.NewContainer()
+
+	if err := container.Fill(c, injectHandler{}); err == nil {
+		t.Error("Expected error for non-pointer target")
+	}
+
+	unexported := &struct {
+		db *genericDatabase `inject:"db"`
+	}{}
+	if err := container.Fill(c, unexported); err == nil || !contains(err.Error(), "unexported") {
+		t.Errorf("Expected unexported field error, got %v", err)
+	}
+}
+
+// TestFillAvailable_SkipsMissing tests that FillAvailable ignores unbound keys
+func TestFillAvailable_SkipsMissing(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("db", &genericDatabase{})
+
+	handler := &injectHandler{}
+	if err := container.FillAvailable(c, handler); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if handler.DB == nil {
+		t.Error("Expected bound field to be injected")
+	}
+	if handler.Logger != nil {
+		t.Error("Expected unbound field to stay nil")
+	}
+}
@@ -0,0 +1,228 @@
+package container
+
+import (
+	"sort"
+
+	"github.com/donnigundala/dg-core/contracts/container"
+)
+
+// Has reports whether a binding or instance exists for the key, in this
+// container or one of its parents. Unlike Make, it never builds anything.
+//
+//	if !c.Has("cache") {
+//	    c.Singleton("cache", newMemoryCache)
+//	}
+func (c *containerImpl) Has(key string) bool {
+	if _, ok := c.lookup(key); ok {
+		return true
+	}
+	_, d := c.deferralFor(key)
+	return d != nil
+}
+
+// Binding returns the metadata of the binding or instance for the key:
+// its lifetime, the provider that registered it, whether it has been
+// resolved and which keys were resolved while building it.
+//
+//	info, ok := c.Binding("db")
+//	// info.Lifetime == container.LifetimeSingleton, info.Provider == "database"
+func (c *containerImpl) Binding(key string) (container.BindingInfo, bool) {
+	m, ok := c.lookup(key)
+	if !ok {
+		if _, d := c.deferralFor(key); d != nil {
+			return container.BindingInfo{
+				Key:      key,
+				Lifetime: container.LifetimeDeferred,
+				Provider: d.provider,
+			}, true
+		}
+		return container.BindingInfo{}, false
+	}
+
+	info := container.BindingInfo{
+		Key:          key,
+		Dependencies: c.root().dependencies(key),
+	}
+
+	if !m.resolved {
+		// Only transients are marked; shared instances are found by lookup
+		info.Lifetime = m.binding.lifetime
+		info.Provider = m.binding.provider
+		info.Resolved = m.binding.resolved
+		return info, true
+	}
+
+	// A singleton or scoped instance is reported through its binding, which
+	// may be bound on a parent of the container that built it
+	if m.owner.built(key) {
+		for current := m.owner; current != nil; current = current.parent {
+			current.mu.RLock()
+			b, bound := current.bindings[key]
+			current.mu.RUnlock()
+			if bound {
+				info.Lifetime = b.lifetime
+				info.Provider = b.provider
+				info.Resolved = true
+				return info, true
+			}
+		}
+	}
+
+	m.owner.mu.RLock()
+	defer m.owner.mu.RUnlock()
+
+	info.Lifetime = container.LifetimeInstance
+	info.Provider = m.owner.owners[key]
+	info.Resolved = true
+	return info, true
+}
+
+// Keys returns every key that can be resolved from the container, including
+// keys bound on parent containers, in sorted order.
+func (c *containerImpl) Keys() []string {
+	seen := make(map[string]bool)
+	for current := c; current != nil; current = current.parent {
+		current.mu.RLock()
+		for key := range current.bindings {
+			seen[key] = true
+		}
+		for key := range current.instances {
+			seen[key] = true
+		}
+		for key := range current.deferred {
+			seen[key] = true
+		}
+		current.mu.RUnlock()
+	}
+
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Forget removes the binding, deferral and any instance for the key from this
+// container. Bindings on parent containers are not affected. The instance is
+// not disposed; call Dispose first if it holds resources.
+func (c *containerImpl) Forget(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.bindings, key)
+	delete(c.instances, key)
+	delete(c.owners, key)
+	delete(c.edges, key)
+	delete(c.deferred, key)
+
+	resolved := c.resolved[:0]
+	for _, k := range c.resolved {
+		if k != key {
+			resolved = append(resolved, k)
+		}
+	}
+	c.resolved = resolved
+}
+
+// SetProvider sets the name of the service provider that is currently
+// registering bindings. Every binding and instance registered until the next
+// call is attributed to it, as reported by Binding; pass an empty name to
+// clear it. See WithProvider to attribute the bindings of one caller only.
+func (c *containerImpl) SetProvider(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.provider = name
+}
+
+// currentProvider returns the provider set with SetProvider.
+func (c *containerImpl) currentProvider() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.provider
+}
+
+// WithProvider returns a view of the container that attributes the bindings,
+// instances and deferrals registered through it to the named provider,
+// whatever SetProvider says. The foundation hands one to each provider's
+// Register, so providers registering concurrently or from within each
+// other's Register are told apart.
+func (c *containerImpl) WithProvider(name string) container.Container {
+	return &attributed{containerImpl: c, provider: name}
+}
+
+// attributed is the view of a container returned by WithProvider.
+type attributed struct {
+	*containerImpl
+	provider string
+}
+
+func (a *attributed) Bind(key string, resolver interface{}) {
+	a.bind(key, resolver, container.LifetimeTransient, a.provider)
+}
+
+func (a *attributed) Singleton(key string, resolver interface{}) {
+	a.bind(key, resolver, container.LifetimeSingleton, a.provider)
+}
+
+func (a *attributed) Scoped(key string, resolver interface{}) {
+	a.bind(key, resolver, container.LifetimeScoped, a.provider)
+}
+
+func (a *attributed) Instance(key string, instance interface{}) {
+	a.instance(key, instance, a.provider)
+}
+
+func (a *attributed) Defer(keys []string, load func() error) {
+	a.deferTo(keys, load, a.provider)
+}
+
+// markResolved records that the transient binding for key has been resolved.
+func (c *containerImpl) markResolved(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if b, ok := c.bindings[key]; ok && !b.resolved {
+		b.resolved = true
+		c.bindings[key] = b
+	}
+}
+
+// built reports whether the instance cached for key was built from a binding.
+func (c *containerImpl) built(key string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return containsKey(c.resolved, key)
+}
+
+// root returns the top-most container of the hierarchy.
+func (c *containerImpl) root() *containerImpl {
+	root := c
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root
+}
+
+// addEdge records that from depends on to.
+func (c *containerImpl) addEdge(from, to string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !containsKey(c.edges[from], to) {
+		c.edges[from] = append(c.edges[from], to)
+	}
+}
+
+// dependencies returns the recorded dependencies of key.
+func (c *containerImpl) dependencies(key string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if len(c.edges[key]) == 0 {
+		return nil
+	}
+	return append([]string(nil), c.edges[key]...)
+}
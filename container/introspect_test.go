@@ -0,0 +1,244 @@
+package container_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+	contractContainer "github.com/donnigundala/dg-core/contracts/container"
+)
+
+// TestHas_DoesNotResolve tests that Has reports bindings without building them
+func TestHas_DoesNotResolve(t *testing.T) {
+	c := container.NewContainer()
+	calls := 0
+	c.Singleton("db", func() interface{} {
+		calls++
+		return "db"
+	})
+
+	if !c.Has("db") {
+		t.Error("Expected db to be bound")
+	}
+	if c.Has("missing") {
+		t.Error("Expected missing to be unbound")
+	}
+	if calls != 0 {
+		t.Errorf("Expected resolver not to be called, got %d calls", calls)
+	}
+
+	scope := c.NewScope()
+	if !scope.Has("db") {
+		t.Error("Expected scope to see parent bindings")
+	}
+}
+
+// TestBinding_Metadata tests the metadata reported for bindings and instances
+func TestBinding_Metadata(t *testing.T) {
+	c := container.NewContainer()
+	c.(interface{ SetProvider(string) }).SetProvider("database")
+	c.Singleton("db", func() interface{} { return "db" })
+	c.Instance("config", "config")
+	c.(interface{ SetProvider(string) }).SetProvider("")
+	c.Bind("repo", func(c contractContainer.Container) interface{} {
+		db, _ := c.Make("db")
+		return db
+	})
+
+	info, ok := c.Binding("db")
+	if !ok {
+		t.Fatal("Expected binding for db")
+	}
+	if info.Lifetime != contractContainer.LifetimeSingleton || info.Provider != "database" || info.Resolved {
+		t.Errorf("Unexpected metadata for db: %+v", info)
+	}
+
+	info, _ = c.Binding("config")
+	if info.Lifetime != contractContainer.LifetimeInstance || info.Provider != "database" || !info.Resolved {
+		t.Errorf("Unexpected metadata for config: %+v", info)
+	}
+
+	if _, err := c.Make("repo"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	info, _ = c.Binding("repo")
+	if !info.Resolved || len(info.Dependencies) != 1 || info.Dependencies[0] != "db" {
+		t.Errorf("Unexpected metadata for repo: %+v", info)
+	}
+	if info, _ := c.Binding("db"); !info.Resolved {
+		t.Error("Expected db to be marked as resolved")
+	}
+
+	if _, ok := c.Binding("missing"); ok {
+		t.Error("Expected no binding for missing")
+	}
+}
+
+// TestWithProvider_AttributesBindings tests that bindings registered through
+// the view returned by WithProvider are attributed to its provider only
+func TestWithProvider_AttributesBindings(t *testing.T) {
+	c := container.NewContainer()
+	c.(interface{ SetProvider(string) }).SetProvider("app")
+	cache := c.(interface {
+		WithProvider(string) contractContainer.Container
+	}).WithProvider("cache")
+
+	cache.Singleton("cache", func() interface{} { return "cache" })
+	cache.Instance("cache.store", "memory")
+	c.Bind("repo", func() interface{} { return "repo" })
+
+	for key, want := range map[string]string{"cache": "cache", "cache.store": "cache", "repo": "app"} {
+		if info, _ := c.Binding(key); info.Provider != want {
+			t.Errorf("Expected %s to be attributed to %s, got %q", key, want, info.Provider)
+		}
+	}
+}
+
+// TestBinding_ResolvedInChild tests that a singleton built in a child
+// container is only reported as resolved there
+func TestBinding_ResolvedInChild(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("notifier", func() interface{} { return "notifier" })
+
+	child := c.Child()
+	if _, err := child.Make("notifier"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if info, _ := child.Binding("notifier"); !info.Resolved || info.Lifetime != contractContainer.LifetimeSingleton {
+		t.Errorf("Expected the child to report a resolved singleton, got %+v", info)
+	}
+	if info, _ := c.Binding("notifier"); info.Resolved {
+		t.Errorf("Expected the parent not to report an instance it never built, got %+v", info)
+	}
+}
+
+// TestKeys_IncludesParents tests that Keys lists keys across the hierarchy
+func TestKeys_IncludesParents(t *testing.T) {
+	c := container.NewContainer()
+	c.Bind("b", func() interface{} { return "b" })
+	c.Instance("a", "a")
+
+	scope := c.NewScope()
+	scope.Instance("request", "req")
+	scope.Instance("a", "shadowed")
+
+	keys := scope.Keys()
+	if strings.Join(keys, ",") != "a,b,request" {
+		t.Errorf("Expected [a b request], got %v", keys)
+	}
+	if len(c.Keys()) != 2 {
+		t.Errorf("Expected parent to have 2 keys, got %v", c.Keys())
+	}
+}
+
+// TestForget_RemovesBinding tests that Forget removes a binding and its instance
+func TestForget_RemovesBinding(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("cache", func() interface{} { return "cache" })
+	if _, err := c.Make("cache"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	c.Forget("cache")
+
+	if c.Has("cache") {
+		t.Error("Expected cache to be forgotten")
+	}
+	if _, err := c.Make("cache"); err == nil {
+		t.Error("Expected error resolving forgotten key")
+	}
+}
+
+// TestExportGraph_JSON tests exporting the dependency graph as JSON
+func TestExportGraph_JSON(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("db", func() interface{} { return "db" })
+	c.Singleton("repo", func(c contractContainer.Container) interface{} {
+		db, _ := c.Make("db")
+		return db
+	})
+	if _, err := c.Make("repo"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := container.ExportGraph(&buf, c, container.GraphFormatJSON); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var graph container.Graph
+	if err := json.Unmarshal(buf.Bytes(), &graph); err != nil {
+		t.Fatalf("Expected valid JSON, got %v", err)
+	}
+	if len(graph.Nodes) != 2 {
+		t.Errorf("Expected 2 nodes, got %d", len(graph.Nodes))
+	}
+	if len(graph.Edges) != 1 || graph.Edges[0] != (container.GraphEdge{From: "repo", To: "db"}) {
+		t.Errorf("Expected edge repo -> db, got %v", graph.Edges)
+	}
+}
+
+// TestExportGraph_Resolve tests that dependencies resolved through Resolve on
+// the injected container are recorded
+func TestExportGraph_Resolve(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("db", func() interface{} { return "db" })
+	c.Singleton("cache", func() interface{} { return "cache" })
+	c.Singleton("repo", func(c contractContainer.Container) interface{} {
+		db, _ := c.Make("db")
+		cache, _ := container.Resolve[string](c, "cache")
+		return db.(string) + cache
+	})
+	if _, err := c.Make("repo"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	graph := container.BuildGraph(c)
+	want := []container.GraphEdge{{From: "repo", To: "db"}, {From: "repo", To: "cache"}}
+	if len(graph.Edges) != 2 || graph.Edges[0] != want[0] || graph.Edges[1] != want[1] {
+		t.Errorf("Expected edges %v, got %v", want, graph.Edges)
+	}
+}
+
+// TestExportGraph_DOT tests exporting the dependency graph as DOT
+func TestExportGraph_DOT(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("db", func() interface{} { return "db" })
+	c.Bind("repo", func(c contractContainer.Container) interface{} {
+		db, _ := c.Make("db")
+		return db
+	})
+	if _, err := c.Make("repo"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := container.ExportGraph(&buf, c, container.GraphFormatDOT); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	dot := buf.String()
+	if !strings.HasPrefix(dot, "digraph container {") {
+		t.Errorf("Expected digraph header, got %s", dot)
+	}
+	if !strings.Contains(dot, `"repo" -> "db";`) {
+		t.Errorf("Expected edge repo -> db, got %s", dot)
+	}
+	if !strings.Contains(dot, `label="db\nsingleton"`) {
+		t.Errorf("Expected db label, got %s", dot)
+	}
+}
+
+// TestExportGraph_UnknownFormat tests the error for an unsupported format
+func TestExportGraph_UnknownFormat(t *testing.T) {
+	c := container.NewContainer()
+
+	var buf bytes.Buffer
+	if err := container.ExportGraph(&buf, c, "svg"); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
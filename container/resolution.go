@@ -0,0 +1,112 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrCircularDependency is returned by Make when a key (directly or
+// indirectly) depends on itself.
+var ErrCircularDependency = errors.New("circular dependency")
+
+// chain is shared by every nested resolution started from a single Make call.
+// It remembers a detected cycle even if a resolver swallows the error, so the
+// broken instance is never cached.
+type chain struct {
+	mu  sync.Mutex
+	err error
+}
+
+// fail records the first cycle detected in the chain.
+func (ch *chain) fail(err error) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	if ch.err == nil {
+		ch.err = err
+	}
+}
+
+// failure returns the cycle detected in the chain, if any.
+func (ch *chain) failure() error {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	return ch.err
+}
+
+// resolution is the view of the container handed to resolvers while a key is
+// being built. Its Make tracks the path of in-flight keys, which allows
+// resolvers to call Make re-entrantly and turns cycles into errors such as
+// "circular dependency: a -> b -> a". Once the resolver returns, the
+// resolution ends: an instance keeping it as its container resolves like the
+// container itself.
+type resolution struct {
+	*containerImpl
+	path  []string
+	chain *chain
+	ended atomic.Bool
+}
+
+// Make resolves the given key as part of the current resolution path, or
+// starts a new path once the resolution has ended.
+func (r *resolution) Make(key string) (interface{}, error) {
+	if r.ended.Load() {
+		return r.containerImpl.Make(key)
+	}
+	return r.containerImpl.resolve(key, r.path, r.chain)
+}
+
+// circularError builds the error for a cycle, listing the whole path.
+func circularError(path []string, key string) error {
+	return fmt.Errorf("%w: %s -> %s", ErrCircularDependency, strings.Join(path, " -> "), key)
+}
+
+// build invokes the binding's resolver with its dependencies.
+func (r *resolution) build(key string, b binding) (interface{}, error) {
+	defer r.ended.Store(true)
+
+	resolver := b.resolver
+	var keys map[int]string
+	if wiring, ok := resolver.(Wiring); ok {
+		resolver, keys = wiring.fn, wiring.keys
+	}
+
+	resolverVal := reflect.ValueOf(resolver)
+	if resolverVal.Kind() != reflect.Func {
+		// If resolver is not a function, return it as is (though Bind usually expects a function)
+		return r.decorate(key, resolver), nil
+	}
+
+	args, err := r.resolveArgs(key, resolverVal.Type(), keys)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := call(key, resolverVal, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// A resolver may have ignored the error of a nested Make
+	if err := r.chain.failure(); err != nil {
+		return nil, err
+	}
+
+	return r.decorate(key, instance), nil
+}
+
+// buildLock returns the mutex that serializes construction of a shared binding.
+func (c *containerImpl) buildLock(key string) *sync.Mutex {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lock, ok := c.building[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		c.building[key] = lock
+	}
+	return lock
+}
@@ -0,0 +1,223 @@
+package container_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/donnigundala/dg-core/container"
+	contractContainer "github.com/donnigundala/dg-core/contracts/container"
+)
+
+type cycleA struct{ B *cycleB }
+type cycleB struct{ A *cycleA }
+
+// makeWithTimeout fails the test instead of hanging if Make deadlocks
+func makeWithTimeout(t *testing.T, c contractContainer.Container, key string) (interface{}, error) {
+	t.Helper()
+
+	type result struct {
+		instance interface{}
+		err      error
+	}
+	done := make(chan result, 1)
+	go func() {
+		instance, err := c.Make(key)
+		done <- result{instance, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.instance, r.err
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Make(%q) deadlocked", key)
+		return nil, nil
+	}
+}
+
+// TestMake_ReentrantSingletons tests that singleton resolvers can call Make
+func TestMake_ReentrantSingletons(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("db", func() interface{} {
+		return &genericDatabase{Host: "localhost"}
+	})
+	c.Singleton("service", func(c contractContainer.Container) interface{} {
+		db, _ := c.Make("db")
+		return &wireService{DB: db.(*genericDatabase)}
+	})
+
+	instance, err := makeWithTimeout(t, c, "service")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if instance.(*wireService).DB.Host != "localhost" {
+		t.Error("Expected nested singleton to be injected")
+	}
+}
+
+// TestMake_CircularDependency tests that a cycle is reported with its path
+func TestMake_CircularDependency(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("a", func(c contractContainer.Container) (interface{}, error) {
+		b, err := c.Make("b")
+		if err != nil {
+			return nil, err
+		}
+		return &cycleA{B: b.(*cycleB)}, nil
+	})
+	c.Singleton("b", func(c contractContainer.Container) (interface{}, error) {
+		a, err := c.Make("a")
+		if err != nil {
+			return nil, err
+		}
+		return &cycleB{A: a.(*cycleA)}, nil
+	})
+
+	_, err := makeWithTimeout(t, c, "a")
+	if !errors.Is(err, container.ErrCircularDependency) {
+		t.Fatalf("Expected circular dependency error, got %v", err)
+	}
+	if !contains(err.Error(), "circular dependency: a -> b -> a") {
+		t.Errorf("Expected error to contain the resolution path, got: %s", err.Error())
+	}
+}
+
+// TestMake_CapturedContainer tests that resolvers using a captured container
+// instead of the injected one start a new path
+func TestMake_CapturedContainer(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("db", func() interface{} { return &genericDatabase{} })
+	c.Singleton("service", func() interface{} {
+		db, _ := c.Make("db")
+		return &wireService{DB: db.(*genericDatabase)}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Make("service"); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// storedContainer keeps the container it was built with, to resolve lazily
+type storedContainer struct {
+	c contractContainer.Container
+}
+
+// TestMake_StoredContainer tests that a container kept by an instance starts
+// a new resolution path once the instance is built
+func TestMake_StoredContainer(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("svc", func(c contractContainer.Container) *storedContainer {
+		return &storedContainer{c: c}
+	})
+	c.Bind("other", func(c contractContainer.Container) interface{} {
+		svc, _ := c.Make("svc")
+		return svc
+	})
+
+	svc, err := container.Resolve[*storedContainer](c, "svc")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if other, err := svc.c.Make("other"); err != nil || other != svc {
+			t.Fatalf("Expected the stored container to resolve other, got %v, %v", other, err)
+		}
+	}
+}
+
+// TestMake_CircularDependencySwallowed tests that ignored cycle errors still fail resolution
+func TestMake_CircularDependencySwallowed(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("a", func(c contractContainer.Container) interface{} {
+		b, _ := c.Make("b")
+		return &cycleA{B: b.(*cycleB)}
+	})
+	c.Singleton("b", func(c contractContainer.Container) interface{} {
+		a, _ := c.Make("a") // error ignored
+		typed, _ := a.(*cycleA)
+		return &cycleB{A: typed}
+	})
+
+	_, err := makeWithTimeout(t, c, "a")
+	if !errors.Is(err, container.ErrCircularDependency) {
+		t.Fatalf("Expected circular dependency error, got %v", err)
+	}
+
+	// The half-built singleton must not have been cached
+	_, err = makeWithTimeout(t, c, "b")
+	if !errors.Is(err, container.ErrCircularDependency) {
+		t.Errorf("Expected 'b' to fail with a circular dependency, got %v", err)
+	}
+}
+
+// TestMake_CircularAutowire tests cycle detection through constructor parameters
+func TestMake_CircularAutowire(t *testing.T) {
+	c := container.NewContainer()
+	container.SingletonType[*cycleA](c, func(c contractContainer.Container) *cycleA {
+		return &cycleA{B: container.MustResolveType[*cycleB](c)}
+	})
+	c.Singleton(container.TypeKey[*cycleB](), func(a *cycleA) *cycleB {
+		return &cycleB{A: a}
+	})
+
+	_, err := makeWithTimeout(t, c, container.TypeKey[*cycleA]())
+	if !errors.Is(err, container.ErrCircularDependency) {
+		t.Fatalf("Expected circular dependency error, got %v", err)
+	}
+}
+
+// TestMake_SelfDependency tests a resolver that resolves its own key
+func TestMake_SelfDependency(t *testing.T) {
+	c := container.NewContainer()
+	c.Bind("self", func(c contractContainer.Container) (interface{}, error) {
+		return c.Make("self")
+	})
+
+	_, err := makeWithTimeout(t, c, "self")
+	if err == nil || !contains(err.Error(), "circular dependency: self -> self") {
+		t.Errorf("Expected self dependency error, got %v", err)
+	}
+}
+
+// TestMake_ConcurrentNestedSingletons tests concurrent resolution of nested singletons
+func TestMake_ConcurrentNestedSingletons(t *testing.T) {
+	c := container.NewContainer()
+
+	var mu sync.Mutex
+	built := 0
+	c.Singleton("db", func() interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		built++
+		return &genericDatabase{}
+	})
+	c.Singleton("service", func(c contractContainer.Container) interface{} {
+		db, _ := c.Make("db")
+		return &wireService{DB: db.(*genericDatabase)}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Make("service"); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if built != 1 {
+		t.Errorf("Expected db to be built once, got %d", built)
+	}
+}
@@ -0,0 +1,62 @@
+package container
+
+import (
+	"errors"
+
+	"github.com/donnigundala/dg-core/contracts/container"
+)
+
+// ErrOutOfScope is returned by Make when a scoped binding is resolved from a
+// container that is not (a descendant of) a scope.
+var ErrOutOfScope = errors.New("scoped binding resolved outside a scope")
+
+// Scoped registers a binding that is shared within a scope.
+//
+// Each scope created with NewScope gets its own instance, which is reused for
+// every Make on that scope until the scope is disposed. Resolving a scoped
+// binding outside a scope returns an error wrapping ErrOutOfScope.
+//
+// Example:
+//
+//	c.Scoped("uow", func(db *sql.DB) *UnitOfWork {
+//	    return NewUnitOfWork(db)
+//	})
+//
+//	scope := c.NewScope()
+//	defer scope.Dispose(ctx)
+//
+//	uow1, _ := scope.Make("uow")
+//	uow2, _ := scope.Make("uow")
+//	// uow1 == uow2, but a different scope gets a different instance
+func (c *containerImpl) Scoped(key string, resolver interface{}) {
+	c.bind(key, resolver, container.LifetimeScoped, c.currentProvider())
+}
+
+// NewScope creates a new scope below the container.
+//
+// The scope resolves every key through the container: singletons are still
+// shared application-wide, while scoped bindings get one instance per scope.
+// Bindings and instances registered directly on the scope are only visible
+// within it, which is useful for per-request values:
+//
+//	scope := app.NewScope()
+//	scope.Instance("request", r)
+//	defer scope.Dispose(r.Context())
+func (c *containerImpl) NewScope() container.Scope {
+	return newContainer(c, true)
+}
+
+// Child creates a container below c that keeps its own bindings, instances
+// and singletons, and falls back to c for every key it does not have.
+//
+// Singletons bound on c that c has not built yet are built and cached by the
+// child, so they see the child's overrides; instances c already holds are
+// shared. Nothing registered on the child is visible to c, which makes
+// children safe for overriding bindings in tests or isolated modules:
+//
+//	child := app.Child()
+//	child.Instance("mailer", fakeMailer)
+//	notifier, _ := child.Make("notifier") // uses fakeMailer, app is untouched
+func (c *containerImpl) Child() container.Container {
+	return newContainer(c, false)
+}
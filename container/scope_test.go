@@ -0,0 +1,225 @@
+package container_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+	contractContainer "github.com/donnigundala/dg-core/contracts/container"
+)
+
+type unitOfWork struct {
+	DB *genericDatabase
+}
+
+// TestScoped_OneInstancePerScope tests that each scope gets its own instance
+func TestScoped_OneInstancePerScope(t *testing.T) {
+	c := container.NewContainer()
+	c.Scoped("uow", func() interface{} {
+		return &unitOfWork{}
+	})
+
+	scope1 := c.NewScope()
+	scope2 := c.NewScope()
+
+	a1, err := scope1.Make("uow")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	a2, _ := scope1.Make("uow")
+	b1, _ := scope2.Make("uow")
+
+	if a1 != a2 {
+		t.Error("Expected same instance within a scope")
+	}
+	if a1 == b1 {
+		t.Error("Expected different instances across scopes")
+	}
+}
+
+// TestScoped_OutsideScope tests that scoped bindings require a scope
+func TestScoped_OutsideScope(t *testing.T) {
+	c := container.NewContainer()
+	c.Scoped("uow", func() interface{} {
+		return &unitOfWork{}
+	})
+
+	_, err := c.Make("uow")
+	if !errors.Is(err, container.ErrOutOfScope) {
+		t.Errorf("Expected ErrOutOfScope, got %v", err)
+	}
+}
+
+// TestScoped_SharesSingletons tests that singletons are shared between scopes
+func TestScoped_SharesSingletons(t *testing.T) {
+	c := container.NewContainer()
+	container.SingletonType[*genericDatabase](c, func(c contractContainer.Container) *genericDatabase {
+		return &genericDatabase{}
+	})
+	c.Scoped("uow", func(db *genericDatabase) *unitOfWork {
+		return &unitOfWork{DB: db}
+	})
+
+	u1, _ := container.Resolve[*unitOfWork](c.NewScope(), "uow")
+	u2, _ := container.Resolve[*unitOfWork](c.NewScope(), "uow")
+
+	if u1 == u2 {
+		t.Error("Expected different scoped instances")
+	}
+	if u1.DB == nil || u1.DB != u2.DB {
+		t.Error("Expected the singleton dependency to be shared")
+	}
+}
+
+// TestScoped_SingletonCannotCaptureScoped tests that singletons cannot depend on scoped bindings
+func TestScoped_SingletonCannotCaptureScoped(t *testing.T) {
+	c := container.NewContainer()
+	c.Scoped("uow", func() *unitOfWork { return &unitOfWork{} })
+	c.Singleton("service", func(c contractContainer.Container) (interface{}, error) {
+		return c.Make("uow")
+	})
+
+	_, err := c.NewScope().Make("service")
+	if !errors.Is(err, container.ErrOutOfScope) {
+		t.Errorf("Expected ErrOutOfScope, got %v", err)
+	}
+}
+
+// TestScope_LocalInstances tests that instances registered on a scope stay local
+func TestScope_LocalInstances(t *testing.T) {
+	c := container.NewContainer()
+	c.Scoped("tenant", func(c contractContainer.Container) (interface{}, error) {
+		return c.Make("tenant_id")
+	})
+
+	scope := c.NewScope()
+	scope.Instance("tenant_id", "acme")
+
+	tenant, err := scope.Make("tenant")
+	if err != nil || tenant != "acme" {
+		t.Errorf("Expected 'acme', got %v (%v)", tenant, err)
+	}
+
+	if _, err := c.Make("tenant_id"); err == nil {
+		t.Error("Expected scope instance not to leak into the parent container")
+	}
+}
+
+// TestScope_Dispose tests that disposing a scope releases its instances
+func TestScope_Dispose(t *testing.T) {
+	c := container.NewContainer()
+	c.Scoped("uow", func() interface{} {
+		return &unitOfWork{}
+	})
+
+	scope := c.NewScope()
+	scope.Instance("request", "req")
+	before, _ := scope.Make("uow")
+
+	if err := scope.Dispose(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	after, _ := scope.Make("uow")
+	if before == after {
+		t.Error("Expected a new instance after dispose")
+	}
+	if req, err := scope.Make("request"); err != nil || req != "req" {
+		t.Error("Expected registered instances to survive dispose")
+	}
+}
+
+// TestChild_OverridesWithoutMutatingParent tests that child bindings shadow the parent's
+func TestChild_OverridesWithoutMutatingParent(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("mailer", "smtp")
+	c.Singleton("notifier", func(c contractContainer.Container) interface{} {
+		mailer, _ := c.Make("mailer")
+		return "notifier:" + mailer.(string)
+	})
+
+	child := c.Child()
+	child.Instance("mailer", "fake")
+
+	notifier, err := child.Make("notifier")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if notifier != "notifier:fake" {
+		t.Errorf("Expected child notifier to use the override, got %v", notifier)
+	}
+
+	notifier, _ = c.Make("notifier")
+	if notifier != "notifier:smtp" {
+		t.Errorf("Expected parent notifier to be unaffected, got %v", notifier)
+	}
+	if mailer, _ := c.Make("mailer"); mailer != "smtp" {
+		t.Errorf("Expected parent mailer to be unaffected, got %v", mailer)
+	}
+}
+
+// TestChild_OverridesReachParentResolvers tests that resolvers bound on the
+// parent container see the overrides of the child building them
+func TestChild_OverridesReachParentResolvers(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("mailer", "smtp")
+	c.Singleton("notifier", func(c contractContainer.Container) interface{} {
+		mailer, _ := c.Make("mailer")
+		return "notifier:" + mailer.(string)
+	})
+
+	child := c.Child()
+	child.Instance("mailer", "fake")
+
+	if notifier, _ := child.Make("notifier"); notifier != "notifier:fake" {
+		t.Errorf("Expected child notifier to use the override, got %v", notifier)
+	}
+	if notifier, _ := c.Make("notifier"); notifier != "notifier:smtp" {
+		t.Errorf("Expected parent notifier to be unaffected, got %v", notifier)
+	}
+}
+
+// TestChild_FallsBackToParent tests that missing keys resolve from the parent
+func TestChild_FallsBackToParent(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("db", func() interface{} { return &genericDatabase{} })
+	db, _ := c.Make("db")
+
+	child := c.Child()
+	childDB, err := child.Make("db")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if childDB != db {
+		t.Error("Expected child to share the singleton already built by the parent")
+	}
+
+	child.Bind("local", func() interface{} { return "local" })
+	if c.Has("local") {
+		t.Error("Expected child bindings to be invisible to the parent")
+	}
+}
+
+// TestChild_KeepsOwnSingletons tests that a child caches the singletons it builds
+func TestChild_KeepsOwnSingletons(t *testing.T) {
+	c := container.NewContainer()
+	c.Singleton("db", func() interface{} { return &genericDatabase{} })
+
+	child := c.Child()
+	first, _ := child.Make("db")
+	second, _ := child.Make("db")
+	if first != second {
+		t.Error("Expected the child to reuse its singleton")
+	}
+
+	// A scope of the child shares the child's singleton
+	scope := child.NewScope()
+	if fromScope, _ := scope.Make("db"); fromScope != first {
+		t.Error("Expected the scope to share the child's singleton")
+	}
+
+	if parentDB, _ := c.Make("db"); parentDB == first {
+		t.Error("Expected the parent to build its own singleton")
+	}
+}
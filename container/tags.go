@@ -0,0 +1,114 @@
+package container
+
+import (
+	"fmt"
+
+	"github.com/donnigundala/dg-core/contracts/container"
+)
+
+// Tag assigns a tag to the given keys, so all of them can later be resolved
+// together with Tagged. This is useful for collecting several implementations
+// of the same role, like health checks, event listeners or console commands:
+//
+//	c.Singleton("health.db", newDatabaseCheck)
+//	c.Singleton("health.cache", newCacheCheck)
+//	c.Tag([]string{"health.db", "health.cache"}, "health.checks")
+//
+// Keys may be tagged before they are bound; tagging a key twice is a no-op.
+func (c *containerImpl) Tag(keys []string, tag string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if !containsKey(c.tags[tag], key) {
+			c.tags[tag] = append(c.tags[tag], key)
+		}
+	}
+}
+
+// Tagged resolves every key with the given tag, in the order they were tagged.
+//
+// Tags registered on parent containers are included. An unknown tag yields an
+// empty slice; if any tagged key fails to resolve, the error is returned.
+//
+//	checks, err := c.Tagged("health.checks")
+//	for _, check := range checks {
+//	    check.(health.Checker).Check(ctx)
+//	}
+//
+// See ResolveTagged for a typed variant.
+func (c *containerImpl) Tagged(tag string) ([]interface{}, error) {
+	return c.tagged(tag, nil, &chain{})
+}
+
+// Tagged resolves every key with the given tag as part of the current
+// resolution path, or starts a new path once the resolution has ended.
+func (r *resolution) Tagged(tag string) ([]interface{}, error) {
+	if r.ended.Load() {
+		return r.containerImpl.Tagged(tag)
+	}
+	return r.containerImpl.tagged(tag, r.path, r.chain)
+}
+
+// tagged implements Tagged for a resolution path.
+func (c *containerImpl) tagged(tag string, path []string, ch *chain) ([]interface{}, error) {
+	keys := c.taggedKeys(tag)
+
+	instances := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		instance, err := c.resolve(key, path, ch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve '%s' tagged '%s': %w", key, tag, err)
+		}
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+// taggedKeys collects the keys with the given tag, starting at the root container.
+func (c *containerImpl) taggedKeys(tag string) []string {
+	var keys []string
+	if c.parent != nil {
+		keys = c.parent.taggedKeys(tag)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, key := range c.tags[tag] {
+		if !containsKey(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// ResolveTagged resolves every key with the given tag and asserts each
+// instance to T:
+//
+//	checks, err := container.ResolveTagged[health.Checker](app, "health.checks")
+func ResolveTagged[T any](c container.Container, tag string) ([]T, error) {
+	instances, err := c.Tagged(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	typed := make([]T, 0, len(instances))
+	for _, instance := range instances {
+		v, err := assertType[T](tag, instance)
+		if err != nil {
+			return nil, err
+		}
+		typed = append(typed, v)
+	}
+	return typed, nil
+}
+
+// containsKey reports whether keys contains key.
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
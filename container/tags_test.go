@@ -0,0 +1,92 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+	contractContainer "github.com/donnigundala/dg-core/contracts/container"
+)
+
+// TestTagged_ResolvesInOrder tests resolving all keys of a tag
+func TestTagged_ResolvesInOrder(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("check.db", "db")
+	c.Bind("check.cache", func() interface{} { return "cache" })
+	c.Tag([]string{"check.db", "check.cache"}, "health.checks")
+	c.Tag([]string{"check.db"}, "health.checks") // duplicate is ignored
+
+	checks, err := c.Tagged("health.checks")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(checks) != 2 || checks[0] != "db" || checks[1] != "cache" {
+		t.Errorf("Expected [db cache], got %v", checks)
+	}
+}
+
+// TestTagged_UnknownTag tests that an unknown tag yields no instances
+func TestTagged_UnknownTag(t *testing.T) {
+	c := container.NewContainer()
+
+	instances, err := c.Tagged("unknown")
+	if err != nil || len(instances) != 0 {
+		t.Errorf("Expected empty result, got %v (%v)", instances, err)
+	}
+}
+
+// TestTagged_MissingBinding tests the error for a tagged key that is not bound
+func TestTagged_MissingBinding(t *testing.T) {
+	c := container.NewContainer()
+	c.Tag([]string{"missing"}, "listeners")
+
+	_, err := c.Tagged("listeners")
+	if err == nil || !contains(err.Error(), "failed to resolve 'missing' tagged 'listeners'") {
+		t.Errorf("Expected resolution error, got %v", err)
+	}
+}
+
+// TestResolveTagged_Typed tests the generic variant
+func TestResolveTagged_Typed(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("en", englishGreeter{})
+	c.Instance("other", englishGreeter{})
+	c.Tag([]string{"en", "other"}, "greeters")
+
+	greeters, err := container.ResolveTagged[genericGreeter](c, "greeters")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(greeters) != 2 || greeters[0].Greet() != "hello" {
+		t.Errorf("Unexpected greeters: %v", greeters)
+	}
+
+	c.Instance("bad", 42)
+	c.Tag([]string{"bad"}, "greeters")
+	if _, err := container.ResolveTagged[genericGreeter](c, "greeters"); err == nil {
+		t.Error("Expected type mismatch error")
+	}
+}
+
+// TestTagged_InResolverAndScope tests tagged resolution from resolvers and scopes
+func TestTagged_InResolverAndScope(t *testing.T) {
+	c := container.NewContainer()
+	c.Instance("a", "a")
+	c.Tag([]string{"a"}, "letters")
+	c.Singleton("letters", func(c contractContainer.Container) ([]interface{}, error) {
+		return c.Tagged("letters")
+	})
+
+	scope := c.NewScope()
+	scope.Instance("b", "b")
+	scope.Tag([]string{"b"}, "letters")
+
+	letters, err := scope.Tagged("letters")
+	if err != nil || len(letters) != 2 {
+		t.Errorf("Expected parent and scope tags, got %v (%v)", letters, err)
+	}
+
+	all, err := container.Resolve[[]interface{}](c, "letters")
+	if err != nil || len(all) != 1 {
+		t.Errorf("Expected resolver to see root tags only, got %v (%v)", all, err)
+	}
+}
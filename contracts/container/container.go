@@ -1,15 +1,78 @@
 package container
 
+import "context"
+
 // Container is the interface for the dependency injection container.
 type Container interface {
 	// Bind registers a binding with the container.
 	Bind(key string, resolver interface{})
 	// Singleton registers a shared binding in the container.
 	Singleton(key string, resolver interface{})
+	// Scoped registers a binding that is shared within a scope.
+	Scoped(key string, resolver interface{})
 	// Instance registers an existing instance as shared in the container.
 	Instance(key string, instance interface{})
 	// Make resolves the given type from the container.
 	Make(key string) (interface{}, error)
+	// Tag assigns a tag to the given keys.
+	Tag(keys []string, tag string)
+	// Tagged resolves every key with the given tag.
+	Tagged(tag string) ([]interface{}, error)
+	// Extend registers a decorator for the instances resolved for a key.
+	Extend(key string, extender func(instance interface{}, c Container) interface{})
+	// Resolving registers a callback that runs after an instance for a key is built.
+	Resolving(key string, callback func(instance interface{}, c Container))
+	// NewScope creates a new scope for scoped bindings.
+	NewScope() Scope
+	// Child creates a container that falls back to this one for missing keys.
+	Child() Container
+	// Dispose closes and releases the instances built by the container.
+	Dispose(ctx context.Context) error
+	// Has reports whether a binding or instance exists for a key.
+	Has(key string) bool
+	// Binding returns the metadata of the binding for a key.
+	Binding(key string) (BindingInfo, bool)
+	// Keys returns every bound key in sorted order.
+	Keys() []string
+	// Forget removes the binding and instance for a key.
+	Forget(key string)
 	// Flush removes all bindings and instances from the container.
 	Flush()
 }
+
+// Scope is a container that holds its own instances of scoped bindings,
+// for example for the duration of a single HTTP request. Disposing the
+// scope releases those instances.
+type Scope interface {
+	Container
+}
+
+// Lifetime describes how long a resolved instance is reused.
+type Lifetime string
+
+const (
+	// LifetimeTransient creates a new instance on every Make.
+	LifetimeTransient Lifetime = "transient"
+	// LifetimeSingleton shares one instance per container.
+	LifetimeSingleton Lifetime = "singleton"
+	// LifetimeScoped shares one instance per scope.
+	LifetimeScoped Lifetime = "scoped"
+	// LifetimeInstance marks an existing instance registered with Instance.
+	LifetimeInstance Lifetime = "instance"
+	// LifetimeDeferred marks a key whose provider has not been loaded yet.
+	LifetimeDeferred Lifetime = "deferred"
+)
+
+// BindingInfo describes a registered binding.
+type BindingInfo struct {
+	// Key is the container key of the binding.
+	Key string `json:"key"`
+	// Lifetime is the lifetime of the binding.
+	Lifetime Lifetime `json:"lifetime"`
+	// Provider is the name of the service provider that registered the binding, if any.
+	Provider string `json:"provider,omitempty"`
+	// Resolved reports whether the binding has been resolved at least once.
+	Resolved bool `json:"resolved"`
+	// Dependencies lists the keys resolved while building the binding.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
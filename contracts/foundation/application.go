@@ -21,6 +21,9 @@ type Application interface {
 	// Environment
 	Environment() string
 	IsProduction() bool
+	IsLocal() bool
+	IsTesting() bool
+	IsStaging() bool
 
 	// Service Providers
 	Register(provider ServiceProvider) error
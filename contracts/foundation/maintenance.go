@@ -0,0 +1,31 @@
+package foundation
+
+import "time"
+
+// MaintenanceMode describes the application while it is down for maintenance.
+// It is stored as JSON, so every process sharing the storage path sees it.
+type MaintenanceMode struct {
+	// Since is when the application went down. Down sets it when it is zero.
+	Since time.Time `json:"since"`
+	// Message is the body of the 503 response. Defaults to "Service Unavailable".
+	Message string `json:"message,omitempty"`
+	// RetryAfter is the value of the Retry-After header, in seconds. The header
+	// is omitted when it is zero.
+	RetryAfter int `json:"retry_after,omitempty"`
+	// Secret lets a client bypass maintenance mode by visiting /<secret>,
+	// which sets a bypass cookie.
+	Secret string `json:"secret,omitempty"`
+	// AllowedIPs bypass maintenance mode. Entries are IP addresses or CIDRs.
+	AllowedIPs []string `json:"allowed_ips,omitempty"`
+}
+
+// MaintenanceProvider is implemented by applications that can be put into
+// maintenance mode.
+type MaintenanceProvider interface {
+	// Down puts the application into maintenance mode.
+	Down(mode MaintenanceMode) error
+	// Up brings the application out of maintenance mode.
+	Up() error
+	// Maintenance returns the current maintenance mode, if the application is down.
+	Maintenance() (MaintenanceMode, bool)
+}
@@ -23,10 +23,32 @@ type PluginProvider interface {
 	// Version returns the plugin version (e.g., "1.0.0")
 	Version() string
 
-	// Dependencies returns a list of plugin names this plugin depends on
+	// Dependencies returns a list of plugin names this plugin depends on.
+	// The application boots them before this plugin. A name may carry a
+	// version constraint, e.g. "cache@^1.2" or "db@>=2.0,<3".
 	Dependencies() []string
 }
 
+// DeferredProvider is an optional interface for providers whose services are
+// not always needed. Such a provider is registered and booted only the first
+// time one of the keys returned by Provides is resolved from the container.
+// The console kernel loads deferred providers that are also CommandProviders,
+// so their commands are available.
+type DeferredProvider interface {
+	ServiceProvider
+
+	// Provides returns the container keys the provider binds.
+	Provides() []string
+}
+
+// OptionalDependencyProvider is an optional interface for plugins that can
+// use other plugins when they are registered. Optional dependencies use the
+// same syntax as Dependencies; present ones are booted first and their
+// version constraint is checked, absent ones are ignored.
+type OptionalDependencyProvider interface {
+	OptionalDependencies() []string
+}
+
 // BeforeRegisterProvider is an optional interface for providers that need
 // to perform actions before registration.
 type BeforeRegisterProvider interface {
@@ -0,0 +1,5 @@
+package http
+
+// ManagerKey is the container key of the server manager run by the serve
+// command.
+const ManagerKey = "http.manager"
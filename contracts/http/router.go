@@ -36,3 +36,16 @@ type Route interface {
 	Name(name string) Route
 	Middleware(middleware ...func(http.Handler) http.Handler) Route
 }
+
+// RouteInfo describes a registered route, for listings such as route:list.
+type RouteInfo struct {
+	Method     string   `json:"method"`
+	Path       string   `json:"path"`
+	Name       string   `json:"name,omitempty"`
+	Middleware []string `json:"middleware,omitempty"`
+}
+
+// RouteLister is implemented by routers that can list their routes.
+type RouteLister interface {
+	Routes() []RouteInfo
+}
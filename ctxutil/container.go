@@ -0,0 +1,21 @@
+package ctxutil
+
+import (
+	"context"
+
+	"github.com/donnigundala/dg-core/contracts/container"
+)
+
+const containerKey contextKey = "container"
+
+// WithContainer stores a container (typically a per-request scope) in the context.
+func WithContainer(ctx context.Context, c container.Container) context.Context {
+	return context.WithValue(ctx, containerKey, c)
+}
+
+// ContainerFromContext retrieves the container from the context.
+// It returns false if no container is stored.
+func ContainerFromContext(ctx context.Context) (container.Container, bool) {
+	c, ok := ctx.Value(containerKey).(container.Container)
+	return c, ok
+}
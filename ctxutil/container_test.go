@@ -0,0 +1,26 @@
+package ctxutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+)
+
+// TestWithContainer_Storage tests storing a container in context
+func TestWithContainer_Storage(t *testing.T) {
+	c := container.NewContainer()
+	ctx := WithContainer(context.Background(), c)
+
+	retrieved, ok := ContainerFromContext(ctx)
+	if !ok || retrieved != c {
+		t.Error("expected to retrieve the same container")
+	}
+}
+
+// TestContainerFromContext_Missing tests retrieving a container from an empty context
+func TestContainerFromContext_Missing(t *testing.T) {
+	if c, ok := ContainerFromContext(context.Background()); ok || c != nil {
+		t.Error("expected no container in empty context")
+	}
+}
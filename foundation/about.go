@@ -0,0 +1,229 @@
+package foundation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/donnigundala/dg-core/config"
+	"github.com/donnigundala/dg-core/contracts/foundation"
+)
+
+// ProviderTiming records how long each lifecycle step of a provider took,
+// and the error of the step that failed, if any.
+type ProviderTiming struct {
+	Provider  string        `json:"provider"`
+	Register  time.Duration `json:"register"`
+	Boot      time.Duration `json:"boot"`
+	AfterBoot time.Duration `json:"after_boot"`
+	// Step is the step that failed: "register", "boot" or "after_boot".
+	Step  string `json:"failed_step,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// diagnostics collects the provider timings, in registration order.
+type diagnostics struct {
+	mu           sync.Mutex
+	timings      []providerTiming
+	bootDuration time.Duration
+}
+
+// providerTiming is the timing of one registered provider instance, so two
+// instances of the same type, such as two database connections, get a row each.
+type providerTiming struct {
+	provider foundation.ServiceProvider
+	timing   *ProviderTiming
+}
+
+// record updates the timing of a provider step, and its error.
+func (app *Application) record(provider foundation.ServiceProvider, step string, duration time.Duration, err error) {
+	d := &app.diagnostics
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var timing *ProviderTiming
+	for _, recorded := range d.timings {
+		if sameProvider(recorded.provider, provider) {
+			timing = recorded.timing
+			break
+		}
+	}
+	if timing == nil {
+		timing = &ProviderTiming{Provider: providerName(provider)}
+		d.timings = append(d.timings, providerTiming{provider: provider, timing: timing})
+	}
+
+	switch step {
+	case "register":
+		timing.Register = duration
+	case "boot":
+		timing.Boot = duration
+	case "after_boot":
+		timing.AfterBoot = duration
+	}
+	if err != nil {
+		timing.Step = step
+		timing.Error = err.Error()
+	}
+}
+
+// sameProvider reports whether a and b are the same provider instance.
+// Providers of a type that cannot be compared are matched by name.
+func sameProvider(a, b foundation.ServiceProvider) bool {
+	ta := reflect.TypeOf(a)
+	if ta != reflect.TypeOf(b) {
+		return false
+	}
+	if !ta.Comparable() {
+		return providerName(a) == providerName(b)
+	}
+	return a == b
+}
+
+// ProviderTimings returns the timings of every provider registered so far,
+// including the ones that failed, in registration order.
+func (app *Application) ProviderTimings() []ProviderTiming {
+	d := &app.diagnostics
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	timings := make([]ProviderTiming, len(d.timings))
+	for i, recorded := range d.timings {
+		timings[i] = *recorded.timing
+	}
+	return timings
+}
+
+// ModuleInfo is a Go module the binary was built with.
+type ModuleInfo struct {
+	Path    string `json:"path"`
+	Version string `json:"version"`
+}
+
+// PluginInfo describes a registered plugin.
+type PluginInfo struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// About describes the running application, for diagnostics.
+type About struct {
+	Name         string            `json:"name,omitempty"`
+	Environment  string            `json:"environment"`
+	GoVersion    string            `json:"go_version"`
+	Module       ModuleInfo        `json:"module"`
+	Dependencies []ModuleInfo      `json:"dependencies,omitempty"`
+	Paths        map[string]string `json:"paths"`
+	Booted       bool              `json:"booted"`
+	BootDuration time.Duration     `json:"boot_duration"`
+	Maintenance  bool              `json:"maintenance"`
+	Plugins      []PluginInfo      `json:"plugins"`
+	Providers    []ProviderTiming  `json:"providers"`
+}
+
+// About returns the Go and module versions, environment, paths, plugins and
+// provider timings of the application.
+func (app *Application) About() About {
+	about := About{
+		Name:        config.GetString("app.name"),
+		Environment: app.Environment(),
+		GoVersion:   runtime.Version(),
+		Paths: map[string]string{
+			"base":     app.BasePath(),
+			"config":   app.ConfigPath(),
+			"database": app.DatabasePath(),
+			"storage":  app.StoragePath(),
+		},
+		Booted:      app.IsBooted(),
+		Maintenance: app.IsDownForMaintenance(),
+		Plugins:     []PluginInfo{},
+		Providers:   app.ProviderTimings(),
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		about.Module = ModuleInfo{Path: info.Main.Path, Version: info.Main.Version}
+		for _, dep := range info.Deps {
+			module := dep
+			if dep.Replace != nil {
+				module = dep.Replace
+			}
+			about.Dependencies = append(about.Dependencies, ModuleInfo{Path: dep.Path, Version: module.Version})
+		}
+	}
+
+	app.diagnostics.mu.Lock()
+	about.BootDuration = app.diagnostics.bootDuration
+	app.diagnostics.mu.Unlock()
+
+	for _, provider := range app.GetProviders() {
+		if plugin, ok := provider.(foundation.PluginProvider); ok {
+			about.Plugins = append(about.Plugins, PluginInfo{
+				Name:         plugin.Name(),
+				Version:      plugin.Version(),
+				Dependencies: plugin.Dependencies(),
+			})
+		}
+	}
+
+	return about
+}
+
+// AboutHandler serves About as JSON. It exposes module versions and paths,
+// so it should only be mounted behind authentication or on an internal port:
+//
+//	router.Get("/_about", app.AboutHandler()).Middleware(requireAdmin)
+func (app *Application) AboutHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(app.About()); err != nil {
+			app.Log().Error("Failed to encode about report", "error", err)
+		}
+	}
+}
+
+// WriteText renders the report as aligned text, for the console.
+func (a About) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "Environment")
+	if a.Name != "" {
+		fmt.Fprintf(tw, "  Application\t%s\n", a.Name)
+	}
+	fmt.Fprintf(tw, "  Environment\t%s\n", a.Environment)
+	fmt.Fprintf(tw, "  Go version\t%s\n", a.GoVersion)
+	if a.Module.Path != "" {
+		fmt.Fprintf(tw, "  Module\t%s %s\n", a.Module.Path, a.Module.Version)
+	}
+	fmt.Fprintf(tw, "  Booted\t%t (%s)\n", a.Booted, a.BootDuration)
+	fmt.Fprintf(tw, "  Maintenance\t%t\n", a.Maintenance)
+
+	fmt.Fprintln(tw, "\nPaths")
+	for _, name := range []string{"base", "config", "database", "storage"} {
+		fmt.Fprintf(tw, "  %s\t%s\n", name, a.Paths[name])
+	}
+
+	fmt.Fprintln(tw, "\nPlugins")
+	for _, plugin := range a.Plugins {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\n", plugin.Name, plugin.Version, strings.Join(plugin.Dependencies, ", "))
+	}
+
+	fmt.Fprintln(tw, "\nProviders\tregister\tboot\tafter boot\terror")
+	for _, p := range a.Providers {
+		failure := ""
+		if p.Error != "" {
+			failure = p.Step + ": " + p.Error
+		}
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n", p.Provider, p.Register, p.Boot, p.AfterBoot, failure)
+	}
+
+	return tw.Flush()
+}
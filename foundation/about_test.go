@@ -0,0 +1,91 @@
+package foundation
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderTimings_RecordsSteps(t *testing.T) {
+	app := New("/tmp/test")
+	assert.NoError(t, app.RegisterPlugin(&MockPlugin{name: "cache", version: "1.0.0"}))
+	assert.NoError(t, app.RegisterPlugin(&LifecycleProvider{MockPlugin: MockPlugin{name: "queue", version: "2.0.0"}}))
+	assert.NoError(t, app.Boot())
+
+	timings := app.ProviderTimings()
+	assert.Len(t, timings, 2)
+	assert.Equal(t, "cache", timings[0].Provider)
+	assert.Equal(t, "queue", timings[1].Provider)
+	assert.Greater(t, timings[1].Register, time.Duration(0))
+	assert.Empty(t, timings[1].Error)
+}
+
+func TestProviderTimings_RecordsFailure(t *testing.T) {
+	app := New("/tmp/test")
+	provider := &LifecycleProvider{MockPlugin: MockPlugin{name: "mailer"}, shouldFailAfterBoot: true}
+	assert.NoError(t, app.Register(provider))
+
+	err := app.Boot()
+	assert.ErrorContains(t, err, "AfterBoot hook failed for provider 'mailer'")
+
+	timings := app.ProviderTimings()
+	assert.Len(t, timings, 1)
+	assert.Equal(t, "after_boot", timings[0].Step)
+	assert.Equal(t, "after boot failed", timings[0].Error)
+}
+
+func TestProviderTimings_SameTypeProviders(t *testing.T) {
+	app := New("/tmp/test")
+	assert.NoError(t, app.Register(&LifecycleProvider{MockPlugin: MockPlugin{name: "database"}}))
+	assert.NoError(t, app.Register(&LifecycleProvider{MockPlugin: MockPlugin{name: "database"}, shouldFailAfterBoot: true}))
+	assert.Error(t, app.Boot())
+
+	// Each instance keeps its own row
+	timings := app.ProviderTimings()
+	assert.Len(t, timings, 2)
+	assert.Equal(t, "database", timings[1].Provider)
+	assert.Empty(t, timings[0].Error)
+	assert.Equal(t, "after boot failed", timings[1].Error)
+}
+
+func TestAbout_Report(t *testing.T) {
+	app := New("/tmp/test")
+	app.SetEnvironment("staging")
+	assert.NoError(t, app.RegisterPlugin(&MockPlugin{name: "cache", version: "1.2.0", dependencies: []string{}}))
+	assert.NoError(t, app.Boot())
+
+	about := app.About()
+	assert.Equal(t, "staging", about.Environment)
+	assert.Equal(t, runtime.Version(), about.GoVersion)
+	assert.Equal(t, "/tmp/test/storage", about.Paths["storage"])
+	assert.True(t, about.Booted)
+	assert.Equal(t, []PluginInfo{{Name: "cache", Version: "1.2.0", Dependencies: []string{}}}, about.Plugins)
+	assert.Len(t, about.Providers, 1)
+
+	var out bytes.Buffer
+	assert.NoError(t, about.WriteText(&out))
+	assert.Contains(t, out.String(), "staging")
+	assert.Contains(t, out.String(), "cache")
+}
+
+func TestAbout_Handler(t *testing.T) {
+	app := New("/tmp/test")
+	assert.NoError(t, app.Boot())
+
+	w := httptest.NewRecorder()
+	app.AboutHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/_about", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var about About
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &about))
+	assert.True(t, about.Booted)
+	assert.Equal(t, runtime.Version(), about.GoVersion)
+}
@@ -1,6 +1,7 @@
 package foundation_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/donnigundala/dg-core/contracts/container"
@@ -57,3 +58,30 @@ func TestApplicationLifecycle(t *testing.T) {
 		t.Error("Resolved instance is not of type *MockService")
 	}
 }
+
+func TestApplicationFork(t *testing.T) {
+	app := foundationImpl.New("/tmp")
+	app.Register(&MockServiceProvider{})
+	app.OnShutdown("servers", func(ctx context.Context) error { return nil })
+	if err := app.Boot(); err != nil {
+		t.Fatalf("Failed to boot: %v", err)
+	}
+
+	fork := app.Fork()
+	fork.Instance("mock_service", &MockService{Value: "fake"})
+
+	if !fork.IsBooted() || len(fork.GetProviders()) != 1 {
+		t.Error("Expected the fork to keep the providers and boot state")
+	}
+	if service, _ := app.Make("mock_service"); service.(*MockService).Value != "" {
+		t.Error("Expected the override to stay in the fork")
+	}
+	if instance, _ := fork.Make("app"); instance != fork {
+		t.Error("Expected the fork to resolve itself as 'app'")
+	}
+
+	report, err := fork.ShutdownWithReport(context.Background())
+	if err != nil || report.Hooks[0].Name != "servers" {
+		t.Errorf("Expected the fork to keep the shutdown hooks, got %+v, %v", report.Hooks, err)
+	}
+}
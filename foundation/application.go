@@ -3,8 +3,9 @@ package foundation
 import (
 	"fmt"
 	"log/slog"
-	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/donnigundala/dg-core/container"
 	contractContainer "github.com/donnigundala/dg-core/contracts/container"
@@ -16,10 +17,21 @@ import (
 type Application struct {
 	contractContainer.Container
 
-	basePath  string
-	providers []ServiceProvider
-	booted    bool
-	shutdown  *shutdownManager
+	// mu guards the provider list and the lifecycle flags, which deferred
+	// providers loaded by concurrent resolutions update from any goroutine.
+	mu                   sync.RWMutex
+	basePath             string
+	providers            []ServiceProvider
+	deferred             []foundation.DeferredProvider // Deferred providers not loaded yet
+	booted               bool
+	booting              bool
+	configLoaded         bool
+	configuredRegistered bool
+	environment          string
+	shutdown             *shutdownManager
+	events               *eventBus
+	maintenance          maintenanceCache
+	diagnostics          diagnostics
 }
 
 // New creates a new Application instance.
@@ -28,6 +40,7 @@ func New(basePath string) *Application {
 		Container: container.NewContainer(),
 		basePath:  basePath,
 		shutdown:  newShutdownManager(),
+		events:    newEventBus(),
 	}
 
 	// Bind the application instance to the container
@@ -37,53 +50,85 @@ func New(basePath string) *Application {
 	return app
 }
 
+// Fork returns an application backed by a child container of app's
+// container. The fork resolves everything bound on app, while the bindings
+// and instances added to it, and the singletons it builds, stay private to
+// it. It starts with app's providers, boot state, environment, shutdown hooks
+// and lifecycle listeners; hooks and listeners added later to either one are
+// not shared.
+//
+// Singletons app has not built yet are built by the fork that resolves them,
+// so the container passed to their resolvers sees its overrides; Dispose the
+// fork's container to close them.
+func (app *Application) Fork() *Application {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	fork := &Application{
+		Container:            app.Child(),
+		basePath:             app.basePath,
+		providers:            append([]ServiceProvider(nil), app.providers...),
+		booted:               app.booted,
+		configLoaded:         app.configLoaded,
+		configuredRegistered: app.configuredRegistered,
+		environment:          app.environment,
+		shutdown:             app.shutdown.clone(),
+		events:               app.events.clone(),
+	}
+
+	fork.Instance("app", fork)
+	fork.Instance("container", fork.Container)
+
+	return fork
+}
+
 // Register registers a service provider.
+//
+// A provider implementing DeferredProvider is only registered (and booted,
+// once the application is) the first time one of its keys is resolved.
 func (app *Application) Register(provider foundation.ServiceProvider) error {
-	// Auto-inject configuration if provider has config fields
-	if err := InjectProviderConfig(provider); err != nil {
-		return fmt.Errorf("config injection failed for provider: %w", err)
+	if deferred, ok := provider.(foundation.DeferredProvider); ok && app.deferProvider(deferred) {
+		return nil
 	}
 
-	// Check for BeforeRegister hook
-	if hook, ok := provider.(foundation.BeforeRegisterProvider); ok {
-		if err := hook.BeforeRegister(app); err != nil {
-			return fmt.Errorf("BeforeRegister hook failed: %w", err)
-		}
-	}
+	return app.register(provider)
+}
 
-	// Register the provider
-	if err := provider.Register(app); err != nil {
+// register registers a provider immediately and boots it if the application
+// is booted (or booting).
+func (app *Application) register(provider foundation.ServiceProvider) error {
+	start := time.Now()
+	err := app.registerProvider(provider)
+	app.record(provider, "register", time.Since(start), err)
+	if err != nil {
 		return err
 	}
 
-	// Check for Shutdown hook
-	if hook, ok := provider.(foundation.ShutdownProvider); ok {
-		app.RegisterShutdownHook(func() {
-			if err := hook.Shutdown(app); err != nil {
-				// Log error if logger is available, otherwise just print to stderr
-				if logger := app.Log(); logger != nil {
-					logger.Error("Provider shutdown failed", "error", err)
-				} else {
-					fmt.Fprintf(os.Stderr, "Provider shutdown failed: %v\n", err)
-				}
-			}
-		})
-	}
-
 	// Add to providers list only after successful registration
+	app.mu.Lock()
 	app.providers = append(app.providers, provider)
+	boot := app.booted || app.booting
+	app.mu.Unlock()
+
+	app.emit(Event{
+		Name:     EventProviderRegistered,
+		Provider: providerName(provider),
+		Duration: time.Since(start),
+	})
 
 	// If app is already booted, boot this provider immediately
-	if app.booted {
-		if err := provider.Boot(app); err != nil {
-			return fmt.Errorf("failed to boot provider: %w", err)
+	if boot {
+		if err := injectProviderDependencies(app, provider, true); err != nil {
+			return fmt.Errorf("dependency injection failed for provider: %w", err)
+		}
+
+		if err := app.bootProvider(provider); err != nil {
+			return err
 		}
 
 		// Check for AfterBoot hook (since we just booted)
-		if hook, ok := provider.(foundation.AfterBootProvider); ok {
-			if err := hook.AfterBoot(app); err != nil {
-				return fmt.Errorf("AfterBoot hook failed: %w", err)
-			}
+		if err := app.afterBoot(provider); err != nil {
+			return err
 		}
 	}
 
@@ -92,40 +137,119 @@ func (app *Application) Register(provider foundation.ServiceProvider) error {
 
 // Boot boots the application and all registered providers.
 func (app *Application) Boot() error {
-	if app.booted {
+	if app.IsBooted() {
 		return nil
 	}
 
-	for _, provider := range app.providers {
-		// Validate plugin dependencies
-		if plugin, ok := provider.(foundation.PluginProvider); ok {
-			for _, dep := range plugin.Dependencies() {
-				if !app.HasPlugin(dep) {
-					return fmt.Errorf("plugin '%s' requires dependency '%s', which is not registered", plugin.Name(), dep)
-				}
-			}
+	start := time.Now()
+	app.emit(Event{Name: EventBooting})
+
+	// Boot plugins after the plugins they depend on. Deferred providers
+	// loaded from now on are booted right away by register.
+	app.mu.Lock()
+	providers, err := bootOrder(app.providers)
+	if err == nil {
+		app.providers = providers
+		app.booting = true
+	}
+	app.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		app.mu.Lock()
+		app.booting = false
+		app.mu.Unlock()
+	}()
+
+	for _, provider := range providers {
+		// All providers are registered now, so every dependency must resolve
+		if err := injectProviderDependencies(app, provider, true); err != nil {
+			return fmt.Errorf("dependency injection failed for provider: %w", err)
 		}
 
-		if err := provider.Boot(app); err != nil {
-			return fmt.Errorf("failed to boot provider: %w", err)
+		if err := app.bootProvider(provider); err != nil {
+			return err
 		}
 	}
 
 	// Run AfterBoot hooks
-	for _, provider := range app.providers {
-		if hook, ok := provider.(foundation.AfterBootProvider); ok {
-			if err := hook.AfterBoot(app); err != nil {
-				return fmt.Errorf("AfterBoot hook failed: %w", err)
-			}
+	for _, provider := range providers {
+		if err := app.afterBoot(provider); err != nil {
+			return err
 		}
 	}
 
+	app.mu.Lock()
 	app.booted = true
+	app.mu.Unlock()
+
+	duration := time.Since(start)
+	app.diagnostics.mu.Lock()
+	app.diagnostics.bootDuration = duration
+	app.diagnostics.mu.Unlock()
+	app.emit(Event{Name: EventBooted, Duration: duration})
+	app.logTimeline()
+
+	return nil
+}
+
+// registerProvider injects the configuration and dependencies of a provider
+// and runs its BeforeRegister hook and Register method.
+func (app *Application) registerProvider(provider foundation.ServiceProvider) error {
+	// Auto-inject configuration if provider has config fields
+	if err := InjectProviderConfig(provider); err != nil {
+		return fmt.Errorf("config injection failed for provider: %w", err)
+	}
+
+	// Inject container dependencies that are already bound
+	if err := injectProviderDependencies(app, provider, false); err != nil {
+		return fmt.Errorf("dependency injection failed for provider: %w", err)
+	}
+
+	// Check for BeforeRegister hook
+	if hook, ok := provider.(foundation.BeforeRegisterProvider); ok {
+		if err := hook.BeforeRegister(app); err != nil {
+			return fmt.Errorf("BeforeRegister hook failed: %w", err)
+		}
+	}
+
+	// Register the provider, attributing its bindings to it
+	return provider.Register(app.registrar(providerName(provider)))
+}
+
+// bootProvider boots a provider and records how long it took.
+func (app *Application) bootProvider(provider foundation.ServiceProvider) error {
+	start := time.Now()
+	err := provider.Boot(app)
+	app.record(provider, "boot", time.Since(start), err)
+	if err != nil {
+		return fmt.Errorf("failed to boot provider '%s': %w", providerName(provider), err)
+	}
+	return nil
+}
+
+// afterBoot runs the AfterBoot hook of a provider, if it has one, and records
+// how long it took.
+func (app *Application) afterBoot(provider foundation.ServiceProvider) error {
+	hook, ok := provider.(foundation.AfterBootProvider)
+	if !ok {
+		return nil
+	}
+
+	start := time.Now()
+	err := hook.AfterBoot(app)
+	app.record(provider, "after_boot", time.Since(start), err)
+	if err != nil {
+		return fmt.Errorf("AfterBoot hook failed for provider '%s': %w", providerName(provider), err)
+	}
 	return nil
 }
 
 // IsBooted checks if the application has been booted.
 func (app *Application) IsBooted() bool {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
 	return app.booted
 }
 
@@ -149,22 +273,12 @@ func (app *Application) StoragePath() string {
 	return filepath.Join(app.basePath, "storage")
 }
 
-// Environment returns the current environment (e.g., local, production).
-func (app *Application) Environment() string {
-	env := os.Getenv("APP_ENV")
-	if env == "" {
-		return "production"
-	}
-	return env
-}
-
-// IsProduction checks if the application is running in production mode.
-func (app *Application) IsProduction() bool {
-	return app.Environment() == "production"
-}
-
-// GetProviders returns all registered service providers.
+// GetProviders returns all registered service providers, in registration
+// order until the application is booted and in boot order afterwards.
 func (app *Application) GetProviders() []foundation.ServiceProvider {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
 	// Return a copy to prevent external modification
 	providers := make([]foundation.ServiceProvider, len(app.providers))
 	copy(providers, app.providers)
@@ -174,7 +288,7 @@ func (app *Application) GetProviders() []foundation.ServiceProvider {
 // HasPlugin checks if a plugin with the given name is registered.
 // Only providers implementing PluginProvider (with Name/Version/Dependencies) are checked.
 func (app *Application) HasPlugin(name string) bool {
-	for _, p := range app.providers {
+	for _, p := range app.GetProviders() {
 		if plugin, ok := p.(foundation.PluginProvider); ok {
 			if plugin.Name() == name {
 				return true
@@ -194,7 +308,8 @@ func (app *Application) HasProvider(name string) bool {
 
 // RegisterPlugin registers a plugin provider with validation.
 // It prevents duplicate plugin registrations by checking if a plugin
-// with the same name is already registered.
+// with the same name is already registered, and skips plugins disabled
+// in the configuration with `plugins.<name>.enabled: false`.
 //
 // Example:
 //
@@ -203,6 +318,12 @@ func (app *Application) HasProvider(name string) bool {
 //	    return fmt.Errorf("failed to register plugin: %w", err)
 //	}
 func (app *Application) RegisterPlugin(plugin foundation.PluginProvider) error {
+	// Skip plugins disabled with plugins.<name>.enabled: false
+	if !pluginEnabled(plugin.Name()) {
+		app.Log().Info("Plugin disabled by configuration", "plugin", plugin.Name())
+		return nil
+	}
+
 	// Validate: prevent duplicate registration
 	if app.HasPlugin(plugin.Name()) {
 		return fmt.Errorf("plugin '%s' is already registered", plugin.Name())
@@ -212,6 +333,54 @@ func (app *Application) RegisterPlugin(plugin foundation.PluginProvider) error {
 	return app.Register(plugin)
 }
 
+// registrar is the application handed to the Register method of a provider:
+// the bindings registered through it are attributed to the provider.
+type registrar struct {
+	*Application
+	bindings contractContainer.Container
+}
+
+func (r *registrar) Bind(key string, resolver interface{}) {
+	r.bindings.Bind(key, resolver)
+}
+
+func (r *registrar) Singleton(key string, resolver interface{}) {
+	r.bindings.Singleton(key, resolver)
+}
+
+func (r *registrar) Scoped(key string, resolver interface{}) {
+	r.bindings.Scoped(key, resolver)
+}
+
+func (r *registrar) Instance(key string, instance interface{}) {
+	r.bindings.Instance(key, instance)
+}
+
+// registrar returns the application attributing its bindings to the named
+// provider.
+func (app *Application) registrar(name string) foundation.Application {
+	return &registrar{Application: app, bindings: app.attributed(name)}
+}
+
+// attributed returns the view of the container attributing its bindings to
+// the named provider, if the container supports it, or the container itself.
+func (app *Application) attributed(name string) contractContainer.Container {
+	if attributor, ok := app.Container.(interface {
+		WithProvider(name string) contractContainer.Container
+	}); ok {
+		return attributor.WithProvider(name)
+	}
+	return app.Container
+}
+
+// providerName returns the plugin name of the provider, or its type name.
+func providerName(provider foundation.ServiceProvider) string {
+	if plugin, ok := provider.(foundation.PluginProvider); ok {
+		return plugin.Name()
+	}
+	return fmt.Sprintf("%T", provider)
+}
+
 // Log returns the application logger.
 // This assumes a logger is bound to the container, or falls back to default.
 func (app *Application) Log() *slog.Logger {
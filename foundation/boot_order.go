@@ -0,0 +1,146 @@
+package foundation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/donnigundala/dg-core/contracts/foundation"
+)
+
+// bootOrder sorts providers so that every plugin is booted after the plugins
+// it depends on, including the optional dependencies that are registered. Providers keep their registration order wherever the
+// dependencies allow it, and providers that are not plugins are never moved
+// relative to each other.
+//
+// An error is returned when a required dependency is not registered, when a
+// dependency's version does not satisfy the constraint, or when plugins
+// depend on each other in a cycle, in which case the whole cycle is reported.
+func bootOrder(providers []ServiceProvider) ([]ServiceProvider, error) {
+	// Index the plugins by name; a dependency refers to the first one
+	plugins := make(map[string]int)
+	for i := len(providers) - 1; i >= 0; i-- {
+		if plugin, ok := providers[i].(foundation.PluginProvider); ok {
+			plugins[plugin.Name()] = i
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make([]int, len(providers))
+	ordered := make([]ServiceProvider, 0, len(providers))
+	var path []string
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visited:
+			return nil
+		case visiting:
+			return cycleError(path, providers[i].(foundation.PluginProvider).Name())
+		}
+
+		state[i] = visiting
+		if plugin, ok := providers[i].(foundation.PluginProvider); ok {
+			deps, err := dependenciesOf(plugin)
+			if err != nil {
+				return err
+			}
+
+			path = append(path, plugin.Name())
+			for _, dep := range deps {
+				j, ok := plugins[dep.name]
+				if !ok {
+					if dep.optional {
+						continue
+					}
+					return fmt.Errorf("plugin '%s' requires dependency '%s', which is not registered", plugin.Name(), dep.name)
+				}
+				if err := dep.check(plugin, providers[j].(foundation.PluginProvider)); err != nil {
+					return err
+				}
+				if err := visit(j); err != nil {
+					return err
+				}
+			}
+			path = path[:len(path)-1]
+		}
+		state[i] = visited
+
+		ordered = append(ordered, providers[i])
+		return nil
+	}
+
+	for i := range providers {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
+// cycleError reports a dependency cycle, starting from the plugin that
+// closes it: "plugin dependency cycle: a -> b -> a".
+func cycleError(path []string, name string) error {
+	for i, inPath := range path {
+		if inPath == name {
+			path = path[i:]
+			break
+		}
+	}
+	return fmt.Errorf("plugin dependency cycle: %s -> %s", strings.Join(path, " -> "), name)
+}
+
+// dependency is a parsed entry of Dependencies or OptionalDependencies, such
+// as "cache" or "cache@^1.2".
+type dependency struct {
+	spec       string
+	name       string
+	constraint *versionConstraint
+	optional   bool
+}
+
+// dependenciesOf parses the required and optional dependencies of a plugin.
+func dependenciesOf(plugin foundation.PluginProvider) ([]dependency, error) {
+	specs := plugin.Dependencies()
+	required := len(specs)
+	if provider, ok := plugin.(foundation.OptionalDependencyProvider); ok {
+		specs = append(specs[:required:required], provider.OptionalDependencies()...)
+	}
+
+	deps := make([]dependency, 0, len(specs))
+	for i, spec := range specs {
+		dep := dependency{spec: spec, name: spec, optional: i >= required}
+		if name, constraint, found := strings.Cut(spec, "@"); found {
+			parsed, err := parseConstraint(constraint)
+			if err != nil {
+				return nil, fmt.Errorf("plugin '%s' has an invalid dependency '%s': %w", plugin.Name(), spec, err)
+			}
+			dep.name, dep.constraint = strings.TrimSpace(name), &parsed
+		}
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
+
+// check verifies that the registered plugin satisfies the version constraint.
+func (d dependency) check(plugin, registered foundation.PluginProvider) error {
+	if d.constraint == nil {
+		return nil
+	}
+
+	v, err := parseVersion(registered.Version())
+	if err != nil {
+		return fmt.Errorf("plugin '%s' requires '%s', but plugin '%s' has an invalid version '%s'",
+			plugin.Name(), d.spec, d.name, registered.Version())
+	}
+	if !d.constraint.allows(v) {
+		return fmt.Errorf("plugin '%s' requires '%s', but version %s is registered",
+			plugin.Name(), d.spec, registered.Version())
+	}
+	return nil
+}
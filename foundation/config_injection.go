@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/donnigundala/dg-core/config"
+	"github.com/donnigundala/dg-core/container"
 )
 
 // InjectProviderConfig scans provider struct for `config:"key"` tags
@@ -64,3 +65,18 @@ func InjectProviderConfig(provider interface{}) error {
 
 	return nil
 }
+
+// injectProviderDependencies fills `inject:"key"` tagged provider fields from
+// the container. Before Register only already-bound keys are injected; with
+// strict set, every tagged field must resolve.
+func injectProviderDependencies(app *Application, provider interface{}, strict bool) error {
+	v := reflect.ValueOf(provider)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil // Not a struct pointer, skip
+	}
+
+	if strict {
+		return container.Fill(app, provider)
+	}
+	return container.FillAvailable(app, provider)
+}
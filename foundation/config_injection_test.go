@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/donnigundala/dg-core/config"
+	"github.com/donnigundala/dg-core/contracts/foundation"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -138,3 +139,50 @@ func TestInjectProviderConfig_MissingConfig(t *testing.T) {
 	// (returns zero values)
 	assert.NoError(t, err)
 }
+
+type injectedService struct{}
+
+type TestProviderWithInject struct {
+	Service  *injectedService `inject:"service"`
+	Late     *injectedService `inject:"late"`
+	register *injectedService
+}
+
+func (p *TestProviderWithInject) Register(app foundation.Application) error {
+	p.register = p.Service
+	return nil
+}
+
+func (p *TestProviderWithInject) Boot(app foundation.Application) error {
+	return nil
+}
+
+func TestInjectProviderDependencies_BeforeRegisterAndBoot(t *testing.T) {
+	app := New("/tmp/test")
+	service := &injectedService{}
+	app.Instance("service", service)
+
+	provider := &TestProviderWithInject{}
+	err := app.Register(provider)
+	assert.NoError(t, err)
+	assert.Same(t, service, provider.register, "bound dependency should be injected before Register")
+	assert.Nil(t, provider.Late, "unbound dependency should be skipped before Register")
+
+	late := &injectedService{}
+	app.Instance("late", late)
+
+	err = app.Boot()
+	assert.NoError(t, err)
+	assert.Same(t, late, provider.Late, "dependency should be injected before Boot")
+}
+
+func TestInjectProviderDependencies_MissingOnBoot(t *testing.T) {
+	app := New("/tmp/test")
+
+	err := app.Register(&TestProviderWithInject{})
+	assert.NoError(t, err)
+
+	err = app.Boot()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "dependency injection failed for provider")
+}
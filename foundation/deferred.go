@@ -0,0 +1,55 @@
+package foundation
+
+import (
+	"github.com/donnigundala/dg-core/contracts/foundation"
+)
+
+// deferProvider postpones the registration of a deferred provider until one
+// of its keys is resolved. It reports false when the container does not
+// support deferral, in which case the provider is registered right away.
+func (app *Application) deferProvider(provider foundation.DeferredProvider) bool {
+	deferrer, ok := app.attributed(providerName(provider)).(interface {
+		Defer(keys []string, load func() error)
+	})
+	if !ok {
+		return false
+	}
+
+	app.mu.Lock()
+	app.deferred = append(app.deferred, provider)
+	app.mu.Unlock()
+
+	deferrer.Defer(provider.Provides(), func() error {
+		app.mu.Lock()
+		for i, pending := range app.deferred {
+			if pending == provider {
+				app.deferred = append(app.deferred[:i:i], app.deferred[i+1:]...)
+				break
+			}
+		}
+		app.mu.Unlock()
+
+		return app.register(provider)
+	})
+
+	return true
+}
+
+// DeferredProviders returns the deferred providers that have not been loaded yet.
+func (app *Application) DeferredProviders() []foundation.DeferredProvider {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	return append([]foundation.DeferredProvider(nil), app.deferred...)
+}
+
+// LoadDeferred registers a deferred provider now, and boots it if the
+// application is booted, as if one of its keys had been resolved. The console
+// kernel loads the deferred providers that add commands this way.
+func (app *Application) LoadDeferred(provider foundation.DeferredProvider) error {
+	loader, ok := app.Container.(interface{ LoadDeferred(key string) error })
+	if !ok || len(provider.Provides()) == 0 {
+		return nil
+	}
+	return loader.LoadDeferred(provider.Provides()[0])
+}
@@ -0,0 +1,191 @@
+package foundation
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	"github.com/stretchr/testify/assert"
+)
+
+// DeferredMailProvider is a deferred provider for testing
+type DeferredMailProvider struct {
+	registered bool
+	booted     bool
+}
+
+func (p *DeferredMailProvider) Provides() []string {
+	return []string{"mailer"}
+}
+
+func (p *DeferredMailProvider) Register(app foundation.Application) error {
+	p.registered = true
+	app.Singleton("mailer", func() interface{} { return "smtp" })
+	return nil
+}
+
+func (p *DeferredMailProvider) Boot(app foundation.Application) error {
+	p.booted = true
+	return nil
+}
+
+// EagerProvider resolves a deferred key while booting
+type EagerProvider struct{}
+
+func (p *EagerProvider) Register(app foundation.Application) error { return nil }
+
+func (p *EagerProvider) Boot(app foundation.Application) error {
+	_, err := app.Make("mailer")
+	return err
+}
+
+func TestDeferredProvider_LoadedOnFirstResolve(t *testing.T) {
+	app := New("/tmp/test")
+	provider := &DeferredMailProvider{}
+
+	assert.NoError(t, app.Register(provider))
+	assert.NoError(t, app.Boot())
+
+	assert.False(t, provider.registered)
+	assert.False(t, provider.booted)
+	assert.True(t, app.Has("mailer"))
+	assert.Empty(t, app.GetProviders())
+
+	mailer, err := app.Make("mailer")
+	assert.NoError(t, err)
+	assert.Equal(t, "smtp", mailer)
+	assert.True(t, provider.registered)
+	assert.True(t, provider.booted)
+	assert.Len(t, app.GetProviders(), 1)
+
+	info, _ := app.Binding("mailer")
+	assert.Equal(t, "*foundation.DeferredMailProvider", info.Provider)
+}
+
+func TestDeferredProvider_ResolvedBeforeBoot(t *testing.T) {
+	app := New("/tmp/test")
+	provider := &DeferredMailProvider{}
+	assert.NoError(t, app.Register(provider))
+
+	_, err := app.Make("mailer")
+	assert.NoError(t, err)
+	assert.True(t, provider.registered)
+	assert.False(t, provider.booted)
+
+	// Booted along with the other providers
+	assert.NoError(t, app.Boot())
+	assert.True(t, provider.booted)
+}
+
+func TestDeferredProvider_ResolvedWhileBooting(t *testing.T) {
+	app := New("/tmp/test")
+	provider := &DeferredMailProvider{}
+	assert.NoError(t, app.Register(provider))
+	assert.NoError(t, app.Register(&EagerProvider{}))
+
+	assert.NoError(t, app.Boot())
+	assert.True(t, provider.registered)
+	assert.True(t, provider.booted)
+}
+
+// namedDeferredProvider is a deferred plugin providing a key named after it
+type namedDeferredProvider struct {
+	name string
+}
+
+func (p *namedDeferredProvider) Name() string           { return p.name }
+func (p *namedDeferredProvider) Version() string        { return "1.0.0" }
+func (p *namedDeferredProvider) Dependencies() []string { return nil }
+func (p *namedDeferredProvider) Provides() []string     { return []string{p.name} }
+
+func (p *namedDeferredProvider) Register(app foundation.Application) error {
+	app.Singleton(p.name, func() interface{} { return p.name })
+	return nil
+}
+
+func (p *namedDeferredProvider) Boot(app foundation.Application) error { return nil }
+
+func TestDeferredProvider_ConcurrentLoads(t *testing.T) {
+	app := New("/tmp/test")
+	names := []string{"cache", "queue", "mail", "search", "storage", "metrics"}
+	for _, name := range names {
+		assert.NoError(t, app.Register(&namedDeferredProvider{name: name}))
+	}
+	assert.NoError(t, app.Boot())
+
+	var wg sync.WaitGroup
+	for _, name := range names {
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func(name string) {
+				defer wg.Done()
+				instance, err := app.Make(name)
+				assert.NoError(t, err)
+				assert.Equal(t, name, instance)
+			}(name)
+		}
+	}
+
+	// Readers of the application state run alongside the loads
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			app.GetProviders()
+			app.ProviderTimings()
+			app.About()
+		}
+	}()
+	wg.Wait()
+
+	assert.Len(t, app.GetProviders(), len(names))
+	assert.Empty(t, app.DeferredProviders())
+	for _, name := range names {
+		info, _ := app.Binding(name)
+		assert.Equal(t, name, info.Provider, "binding attributed to the wrong provider")
+	}
+}
+
+// nestingProvider registers another provider, and resolves a deferred key,
+// from its Register
+type nestingProvider struct {
+	nested foundation.ServiceProvider
+}
+
+func (p *nestingProvider) Register(app foundation.Application) error {
+	if err := app.Register(p.nested); err != nil {
+		return err
+	}
+	if _, err := app.Make("mailer"); err != nil {
+		return err
+	}
+	app.Instance("nesting", true)
+	return nil
+}
+
+func (p *nestingProvider) Boot(app foundation.Application) error { return nil }
+
+func TestRegister_FromRegister(t *testing.T) {
+	app := New("/tmp/test")
+	assert.NoError(t, app.Register(&DeferredMailProvider{}))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Register(&nestingProvider{nested: &namedDeferredProvider{name: "cache"}})
+	}()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Register deadlocked")
+	}
+
+	// Each binding is attributed to the provider that registered it
+	mailer, _ := app.Binding("mailer")
+	assert.Equal(t, "*foundation.DeferredMailProvider", mailer.Provider)
+	cache, _ := app.Binding("cache")
+	assert.Equal(t, "cache", cache.Provider)
+	nesting, _ := app.Binding("nesting")
+	assert.Equal(t, "*foundation.nestingProvider", nesting.Provider)
+}
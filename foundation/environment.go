@@ -0,0 +1,80 @@
+package foundation
+
+import (
+	"os"
+	"strings"
+
+	"github.com/donnigundala/dg-core/config"
+)
+
+// SetEnvironment forces the environment, taking precedence over every other
+// source. Console kernels call it for their --env flag.
+func (app *Application) SetEnvironment(env string) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	app.environment = env
+}
+
+// Environment returns the current environment (e.g., local, production).
+//
+// It is resolved from, in order: SetEnvironment, the --env command-line
+// flag, the APP_ENV environment variable (which may come from .env) and the
+// app.env configuration value. It defaults to "production".
+func (app *Application) Environment() string {
+	app.mu.RLock()
+	env := app.environment
+	app.mu.RUnlock()
+	if env != "" {
+		return env
+	}
+	if env := EnvFlag(os.Args[1:]); env != "" {
+		return env
+	}
+	if env := os.Getenv("APP_ENV"); env != "" {
+		return env
+	}
+	if env := config.GetString("app.env"); env != "" {
+		return env
+	}
+	return "production"
+}
+
+// IsProduction checks if the application is running in production mode.
+func (app *Application) IsProduction() bool {
+	return app.Environment() == "production"
+}
+
+// IsLocal checks if the application is running on a developer machine
+// (local or development).
+func (app *Application) IsLocal() bool {
+	env := app.Environment()
+	return env == "local" || env == "development"
+}
+
+// IsTesting checks if the application is running tests (testing or test).
+func (app *Application) IsTesting() bool {
+	env := app.Environment()
+	return env == "testing" || env == "test"
+}
+
+// IsStaging checks if the application is running in staging.
+func (app *Application) IsStaging() bool {
+	return app.Environment() == "staging"
+}
+
+// EnvFlag finds the value of --env=<env> or --env <env> in the arguments,
+// stopping at "--".
+func EnvFlag(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if value, ok := strings.CutPrefix(arg, "--env="); ok {
+			return value
+		}
+		if arg == "--env" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
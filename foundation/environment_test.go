@@ -0,0 +1,43 @@
+package foundation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironment_Resolution(t *testing.T) {
+	t.Setenv("APP_ENV", "")
+	app := New("/tmp/test")
+	assert.Equal(t, "production", app.Environment())
+	assert.True(t, app.IsProduction())
+
+	t.Setenv("APP_ENV", "staging")
+	assert.Equal(t, "staging", app.Environment())
+	assert.True(t, app.IsStaging())
+
+	// An explicit environment wins over the environment variable
+	app.SetEnvironment("testing")
+	assert.Equal(t, "testing", app.Environment())
+	assert.True(t, app.IsTesting())
+	assert.False(t, app.IsLocal())
+}
+
+func TestEnvironment_Helpers(t *testing.T) {
+	app := New("/tmp/test")
+
+	for env, local := range map[string]bool{"local": true, "development": true, "staging": false} {
+		app.SetEnvironment(env)
+		assert.Equal(t, local, app.IsLocal(), env)
+	}
+
+	app.SetEnvironment("test")
+	assert.True(t, app.IsTesting())
+}
+
+func TestEnvFlag(t *testing.T) {
+	assert.Equal(t, "local", EnvFlag([]string{"serve", "--env=local"}))
+	assert.Equal(t, "staging", EnvFlag([]string{"--env", "staging", "migrate"}))
+	assert.Equal(t, "", EnvFlag([]string{"serve", "--", "--env=local"}))
+	assert.Equal(t, "", EnvFlag([]string{"--env"}))
+}
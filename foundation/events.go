@@ -0,0 +1,133 @@
+package foundation
+
+import (
+	"sync"
+	"time"
+)
+
+// LifecycleEvent names a milestone in the life of the application.
+type LifecycleEvent string
+
+const (
+	// EventProviderRegistered is published after each provider is registered.
+	EventProviderRegistered LifecycleEvent = "provider.registered"
+	// EventBooting is published when Boot starts.
+	EventBooting LifecycleEvent = "booting"
+	// EventBooted is published after every provider and AfterBoot hook ran.
+	EventBooted LifecycleEvent = "booted"
+	// EventServing is published by Run once the servers are started.
+	EventServing LifecycleEvent = "serving"
+	// EventShuttingDown is published when Shutdown starts.
+	EventShuttingDown LifecycleEvent = "shutting-down"
+	// EventTerminated is published when Shutdown is done.
+	EventTerminated LifecycleEvent = "terminated"
+)
+
+// Event is published to the listeners registered with On.
+type Event struct {
+	// Name is the milestone that was reached.
+	Name LifecycleEvent `json:"name"`
+	// Time is when the event was published.
+	Time time.Time `json:"time"`
+	// Elapsed is the time since the application was created.
+	Elapsed time.Duration `json:"elapsed"`
+	// Duration is how long the step ending with the event took: registering
+	// the provider, booting or shutting down. It is zero for other events.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Provider is the provider name of EventProviderRegistered.
+	Provider string `json:"provider,omitempty"`
+	// Err is the shutdown error of EventTerminated, if any.
+	Err error `json:"-"`
+}
+
+// EventListener receives lifecycle events.
+type EventListener func(event Event)
+
+// eventBus dispatches lifecycle events and keeps the timeline.
+type eventBus struct {
+	mu        sync.RWMutex
+	started   time.Time
+	listeners map[LifecycleEvent][]EventListener
+	timeline  []Event
+}
+
+// newEventBus creates an event bus whose clock starts now.
+func newEventBus() *eventBus {
+	return &eventBus{
+		started:   time.Now(),
+		listeners: make(map[LifecycleEvent][]EventListener),
+	}
+}
+
+// clone returns a bus with the same clock, listeners and timeline.
+func (b *eventBus) clone() *eventBus {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	listeners := make(map[LifecycleEvent][]EventListener, len(b.listeners))
+	for name, registered := range b.listeners {
+		listeners[name] = append([]EventListener(nil), registered...)
+	}
+	return &eventBus{
+		started:   b.started,
+		listeners: listeners,
+		timeline:  append([]Event(nil), b.timeline...),
+	}
+}
+
+// On registers a listener for a lifecycle event. Listeners run synchronously,
+// in registration order, on the goroutine that reached the milestone.
+//
+// Example:
+//
+//	app.On(foundation.EventBooted, func(e foundation.Event) {
+//	    log.Info("application booted", "duration", e.Duration)
+//	})
+func (app *Application) On(name LifecycleEvent, listener EventListener) {
+	app.events.mu.Lock()
+	defer app.events.mu.Unlock()
+	app.events.listeners[name] = append(app.events.listeners[name], listener)
+}
+
+// Timeline returns every lifecycle event published so far, in order.
+func (app *Application) Timeline() []Event {
+	app.events.mu.RLock()
+	defer app.events.mu.RUnlock()
+
+	timeline := make([]Event, len(app.events.timeline))
+	copy(timeline, app.events.timeline)
+	return timeline
+}
+
+// emit publishes an event to its listeners. A panicking listener is logged
+// and does not stop the others.
+func (app *Application) emit(event Event) {
+	event.Time = time.Now()
+
+	app.events.mu.Lock()
+	event.Elapsed = event.Time.Sub(app.events.started)
+	app.events.timeline = append(app.events.timeline, event)
+	listeners := make([]EventListener, len(app.events.listeners[event.Name]))
+	copy(listeners, app.events.listeners[event.Name])
+	app.events.mu.Unlock()
+
+	for _, listener := range listeners {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					app.Log().Error("Lifecycle listener panicked", "event", event.Name, "panic", r)
+				}
+			}()
+			listener(event)
+		}()
+	}
+}
+
+// logTimeline logs the startup timeline at debug level.
+func (app *Application) logTimeline() {
+	logger := app.Log()
+	for _, event := range app.Timeline() {
+		logger.Debug("Lifecycle event", "event", event.Name, "elapsed", event.Elapsed,
+			"duration", event.Duration, "provider", event.Provider)
+	}
+}
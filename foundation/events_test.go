@@ -0,0 +1,65 @@
+package foundation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvents_PublishedThroughLifecycle(t *testing.T) {
+	app := New("/tmp/test")
+	var names []LifecycleEvent
+	record := func(e Event) { names = append(names, e.Name) }
+	for _, name := range []LifecycleEvent{
+		EventProviderRegistered, EventBooting, EventBooted, EventShuttingDown, EventTerminated,
+	} {
+		app.On(name, record)
+	}
+
+	var registered Event
+	app.On(EventProviderRegistered, func(e Event) { registered = e })
+
+	assert.NoError(t, app.RegisterPlugin(&MockPlugin{name: "cache", version: "1.0.0"}))
+	assert.NoError(t, app.Boot())
+	assert.NoError(t, app.Boot()) // Already booted, no new events
+	assert.NoError(t, app.Shutdown(context.Background()))
+
+	assert.Equal(t, []LifecycleEvent{
+		EventProviderRegistered, EventBooting, EventBooted, EventShuttingDown, EventTerminated,
+	}, names)
+	assert.Equal(t, "cache", registered.Provider)
+	assert.False(t, registered.Time.IsZero())
+}
+
+func TestEvents_Timeline(t *testing.T) {
+	app := New("/tmp/test")
+	assert.NoError(t, app.Boot())
+
+	timeline := app.Timeline()
+	assert.Len(t, timeline, 2)
+	assert.Equal(t, EventBooted, timeline[1].Name)
+	assert.GreaterOrEqual(t, timeline[1].Elapsed, timeline[0].Elapsed)
+}
+
+func TestEvents_ListenerPanicIsRecovered(t *testing.T) {
+	app := New("/tmp/test")
+	called := false
+	app.On(EventBooting, func(e Event) { panic("listener failed") })
+	app.On(EventBooting, func(e Event) { called = true })
+
+	assert.NoError(t, app.Boot())
+	assert.True(t, called)
+}
+
+func TestEvents_TerminatedCarriesError(t *testing.T) {
+	app := New("/tmp/test")
+	var terminated Event
+	app.On(EventTerminated, func(e Event) { terminated = e })
+	app.OnShutdown("fails", func(ctx context.Context) error { return assert.AnError })
+
+	err := app.Shutdown(context.Background())
+
+	assert.ErrorIs(t, terminated.Err, assert.AnError)
+	assert.Equal(t, err, terminated.Err)
+}
@@ -125,3 +125,26 @@ func TestLifecycle_LateRegistration(t *testing.T) {
 	assert.True(t, provider.booted)
 	assert.True(t, provider.afterBootCalled)
 }
+
+type closableService struct {
+	closed bool
+}
+
+func (s *closableService) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestLifecycle_Shutdown_DisposesSingletons(t *testing.T) {
+	app := New("/tmp/test")
+	service := &closableService{}
+	app.Singleton("closable", func() interface{} { return service })
+
+	_, err := app.Make("closable")
+	assert.NoError(t, err)
+
+	err = app.Shutdown(context.Background())
+
+	assert.NoError(t, err)
+	assert.True(t, service.closed)
+}
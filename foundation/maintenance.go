@@ -0,0 +1,139 @@
+package foundation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/donnigundala/dg-core/contracts/foundation"
+)
+
+// maintenanceCache keeps the last maintenance file read, so checking the
+// mode on every request only costs a stat.
+type maintenanceCache struct {
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	mode    foundation.MaintenanceMode
+	down    bool
+}
+
+// MaintenanceFile returns the path of the file that holds the maintenance
+// mode: storage/framework/down.
+func (app *Application) MaintenanceFile() string {
+	return filepath.Join(app.StoragePath(), "framework", "down")
+}
+
+// Down puts the application into maintenance mode. The mode is written to
+// MaintenanceFile, so every process using the same storage path goes down,
+// and stays down across restarts until Up is called.
+//
+// Example:
+//
+//	app.Down(foundation.MaintenanceMode{
+//	    RetryAfter: 60,
+//	    Secret:     "1630542a-246b-4b66-afa1-dd72a4c43515",
+//	})
+func (app *Application) Down(mode foundation.MaintenanceMode) error {
+	if mode.Since.IsZero() {
+		mode.Since = time.Now()
+	}
+
+	data, err := json.Marshal(mode)
+	if err != nil {
+		return fmt.Errorf("failed to encode maintenance mode: %w", err)
+	}
+
+	path := app.MaintenanceFile()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create maintenance directory: %w", err)
+	}
+
+	// Write then rename, so readers never see a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".down-*")
+	if err != nil {
+		return fmt.Errorf("failed to write maintenance file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write maintenance file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write maintenance file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write maintenance file: %w", err)
+	}
+
+	app.Log().Warn("Application is now in maintenance mode")
+	return nil
+}
+
+// Up brings the application out of maintenance mode. It does nothing if the
+// application is not down.
+func (app *Application) Up() error {
+	err := os.Remove(app.MaintenanceFile())
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove maintenance file: %w", err)
+	}
+
+	if err == nil {
+		app.Log().Info("Application is now live")
+	}
+	return nil
+}
+
+// IsDownForMaintenance reports whether the application is in maintenance mode.
+func (app *Application) IsDownForMaintenance() bool {
+	_, down := app.Maintenance()
+	return down
+}
+
+// Maintenance returns the current maintenance mode and whether the
+// application is down. The maintenance file is only read again when it
+// changed; an unreadable file counts as down, with the default settings.
+func (app *Application) Maintenance() (foundation.MaintenanceMode, bool) {
+	path := app.MaintenanceFile()
+	info, err := os.Stat(path)
+
+	cache := &app.maintenance
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if err != nil {
+		cache.down = false
+		cache.mode = foundation.MaintenanceMode{}
+		cache.modTime = time.Time{}
+		return cache.mode, false
+	}
+
+	if cache.down && info.ModTime().Equal(cache.modTime) && info.Size() == cache.size {
+		return cache.mode, true
+	}
+
+	var mode foundation.MaintenanceMode
+	data, err := os.ReadFile(path)
+	if err == nil {
+		err = json.Unmarshal(data, &mode)
+	}
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return foundation.MaintenanceMode{}, false
+		}
+		app.Log().Error("Failed to read maintenance file", "path", path, "error", err)
+		mode = foundation.MaintenanceMode{}
+	}
+
+	cache.down = true
+	cache.mode = mode
+	cache.modTime = info.ModTime()
+	cache.size = info.Size()
+	return mode, true
+}
@@ -0,0 +1,59 @@
+package foundation
+
+import (
+	"os"
+	"testing"
+
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenance_DownAndUp(t *testing.T) {
+	app := New(t.TempDir())
+	assert.False(t, app.IsDownForMaintenance())
+
+	err := app.Down(foundation.MaintenanceMode{Message: "Upgrading", RetryAfter: 60})
+	assert.NoError(t, err)
+	assert.FileExists(t, app.MaintenanceFile())
+
+	mode, down := app.Maintenance()
+	assert.True(t, down)
+	assert.Equal(t, "Upgrading", mode.Message)
+	assert.Equal(t, 60, mode.RetryAfter)
+	assert.False(t, mode.Since.IsZero())
+
+	assert.NoError(t, app.Up())
+	assert.False(t, app.IsDownForMaintenance())
+
+	// Up is a no-op when the application is live
+	assert.NoError(t, app.Up())
+}
+
+func TestMaintenance_SharedThroughStorage(t *testing.T) {
+	base := t.TempDir()
+	first := New(base)
+	second := New(base)
+
+	assert.NoError(t, first.Down(foundation.MaintenanceMode{Secret: "let-me-in"}))
+	mode, down := second.Maintenance()
+	assert.True(t, down)
+	assert.Equal(t, "let-me-in", mode.Secret)
+
+	// A new Down replaces the cached mode
+	assert.NoError(t, first.Down(foundation.MaintenanceMode{Message: "Still down"}))
+	mode, _ = second.Maintenance()
+	assert.Equal(t, "Still down", mode.Message)
+
+	assert.NoError(t, first.Up())
+	assert.False(t, second.IsDownForMaintenance())
+}
+
+func TestMaintenance_CorruptFileIsDown(t *testing.T) {
+	app := New(t.TempDir())
+	assert.NoError(t, app.Down(foundation.MaintenanceMode{}))
+	assert.NoError(t, os.WriteFile(app.MaintenanceFile(), []byte("not json"), 0o644))
+
+	mode, down := app.Maintenance()
+	assert.True(t, down)
+	assert.Empty(t, mode.Message)
+}
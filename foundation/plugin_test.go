@@ -96,3 +96,28 @@ func TestRegisterPlugin_SameNameDifferentInstance(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "already registered")
 }
+
+// bindingPlugin binds a service while registering.
+type bindingPlugin struct {
+	MockPlugin
+}
+
+func (p *bindingPlugin) Register(app foundation.Application) error {
+	app.Singleton("cache", func() interface{} { return "cache" })
+	return nil
+}
+
+func TestRegister_RecordsProviderOfBindings(t *testing.T) {
+	app := New("/tmp/test")
+	plugin := &bindingPlugin{MockPlugin{name: "cache-plugin", version: "1.0.0"}}
+
+	assert.NoError(t, app.RegisterPlugin(plugin))
+	app.Bind("after", func() interface{} { return "after" })
+
+	info, ok := app.Binding("cache")
+	assert.True(t, ok)
+	assert.Equal(t, "cache-plugin", info.Provider)
+
+	info, _ = app.Binding("after")
+	assert.Empty(t, info.Provider)
+}
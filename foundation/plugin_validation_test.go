@@ -1,6 +1,7 @@
 package foundation_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/donnigundala/dg-core/contracts/foundation"
@@ -62,3 +63,137 @@ func TestApplication_PluginDependencyValidation(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+// orderedPlugin records when it is booted and shut down.
+type orderedPlugin struct {
+	mockPlugin
+	events *[]string
+}
+
+func (p *orderedPlugin) Boot(app foundation.Application) error {
+	*p.events = append(*p.events, "boot:"+p.name)
+	return nil
+}
+
+func (p *orderedPlugin) Shutdown(app foundation.Application) error {
+	*p.events = append(*p.events, "shutdown:"+p.name)
+	return nil
+}
+
+func TestApplication_PluginBootOrder(t *testing.T) {
+	t.Run("Boot boots dependencies first", func(t *testing.T) {
+		app := core.New(".")
+		var events []string
+
+		// Registered in the opposite order of their dependencies
+		assert.NoError(t, app.RegisterPlugin(&orderedPlugin{mockPlugin{name: "api", deps: []string{"cache", "db"}}, &events}))
+		assert.NoError(t, app.RegisterPlugin(&orderedPlugin{mockPlugin{name: "cache", deps: []string{"db"}}, &events}))
+		assert.NoError(t, app.RegisterPlugin(&orderedPlugin{mockPlugin{name: "db"}, &events}))
+
+		assert.NoError(t, app.Boot())
+		assert.Equal(t, []string{"boot:db", "boot:cache", "boot:api"}, events)
+	})
+
+	t.Run("Shutdown runs in reverse boot order", func(t *testing.T) {
+		app := core.New(".")
+		var events []string
+
+		assert.NoError(t, app.RegisterPlugin(&orderedPlugin{mockPlugin{name: "api", deps: []string{"db"}}, &events}))
+		assert.NoError(t, app.RegisterPlugin(&orderedPlugin{mockPlugin{name: "queue"}, &events}))
+		assert.NoError(t, app.RegisterPlugin(&orderedPlugin{mockPlugin{name: "db"}, &events}))
+		assert.NoError(t, app.Boot())
+
+		events = nil
+		assert.NoError(t, app.Shutdown(context.Background()))
+		assert.Equal(t, []string{"shutdown:queue", "shutdown:api", "shutdown:db"}, events)
+	})
+
+	t.Run("Boot reports the whole cycle", func(t *testing.T) {
+		app := core.New(".")
+
+		assert.NoError(t, app.RegisterPlugin(&mockPlugin{name: "standalone"}))
+		assert.NoError(t, app.RegisterPlugin(&mockPlugin{name: "a", deps: []string{"b"}}))
+		assert.NoError(t, app.RegisterPlugin(&mockPlugin{name: "b", deps: []string{"c"}}))
+		assert.NoError(t, app.RegisterPlugin(&mockPlugin{name: "c", deps: []string{"a"}}))
+
+		err := app.Boot()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "plugin dependency cycle: a -> b -> c -> a")
+		assert.False(t, app.IsBooted())
+	})
+}
+
+// versionedPlugin has a version and optional dependencies.
+type versionedPlugin struct {
+	orderedPlugin
+	version  string
+	optional []string
+}
+
+func (p *versionedPlugin) Version() string                { return p.version }
+func (p *versionedPlugin) OptionalDependencies() []string { return p.optional }
+
+func TestApplication_PluginVersionConstraints(t *testing.T) {
+	newPlugin := func(name, version string, deps, optional []string, events *[]string) *versionedPlugin {
+		return &versionedPlugin{
+			orderedPlugin: orderedPlugin{mockPlugin{name: name, deps: deps}, events},
+			version:       version,
+			optional:      optional,
+		}
+	}
+
+	t.Run("Boot succeeds when the version satisfies the constraint", func(t *testing.T) {
+		app := core.New(".")
+		var events []string
+
+		assert.NoError(t, app.RegisterPlugin(newPlugin("api", "1.0.0", []string{"cache@^1.2", "db@>=2.0,<3"}, nil, &events)))
+		assert.NoError(t, app.RegisterPlugin(newPlugin("cache", "1.4.2", nil, nil, &events)))
+		assert.NoError(t, app.RegisterPlugin(newPlugin("db", "2.1.0", nil, nil, &events)))
+
+		assert.NoError(t, app.Boot())
+		assert.Equal(t, []string{"boot:cache", "boot:db", "boot:api"}, events)
+	})
+
+	t.Run("Boot fails when the version is out of range", func(t *testing.T) {
+		app := core.New(".")
+		var events []string
+
+		assert.NoError(t, app.RegisterPlugin(newPlugin("api", "1.0.0", []string{"cache@^1.2"}, nil, &events)))
+		assert.NoError(t, app.RegisterPlugin(newPlugin("cache", "1.1.0", nil, nil, &events)))
+
+		err := app.Boot()
+		assert.EqualError(t, err, "plugin 'api' requires 'cache@^1.2', but version 1.1.0 is registered")
+		assert.Empty(t, events)
+	})
+
+	t.Run("Boot fails on an invalid constraint", func(t *testing.T) {
+		app := core.New(".")
+
+		assert.NoError(t, app.RegisterPlugin(newPlugin("api", "1.0.0", []string{"cache@^one"}, nil, new([]string))))
+
+		err := app.Boot()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "plugin 'api' has an invalid dependency 'cache@^one'")
+	})
+
+	t.Run("Optional dependencies are booted first when present", func(t *testing.T) {
+		app := core.New(".")
+		var events []string
+
+		assert.NoError(t, app.RegisterPlugin(newPlugin("api", "1.0.0", nil, []string{"metrics@^2", "tracing"}, &events)))
+		assert.NoError(t, app.RegisterPlugin(newPlugin("metrics", "2.3.0", nil, nil, &events)))
+
+		assert.NoError(t, app.Boot())
+		assert.Equal(t, []string{"boot:metrics", "boot:api"}, events)
+	})
+
+	t.Run("Optional dependencies are version checked", func(t *testing.T) {
+		app := core.New(".")
+
+		assert.NoError(t, app.RegisterPlugin(newPlugin("api", "1.0.0", nil, []string{"metrics@^2"}, new([]string))))
+		assert.NoError(t, app.RegisterPlugin(newPlugin("metrics", "1.0.0", nil, nil, new([]string))))
+
+		err := app.Boot()
+		assert.EqualError(t, err, "plugin 'api' requires 'metrics@^2', but version 1.0.0 is registered")
+	})
+}
@@ -0,0 +1,141 @@
+package foundation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/donnigundala/dg-core/config"
+	"github.com/donnigundala/dg-core/contracts/foundation"
+)
+
+// ProviderFactory creates a new instance of a named provider.
+type ProviderFactory func() ServiceProvider
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = make(map[string]ProviderFactory)
+)
+
+// RegisterFactory makes a provider available by name to RegisterConfigured.
+// Plugins usually call it from an init function:
+//
+//	func init() {
+//	    foundation.RegisterFactory("cache", func() foundation.ServiceProvider {
+//	        return &CacheProvider{}
+//	    })
+//	}
+//
+// It panics if the factory is nil or the name is already registered.
+func RegisterFactory(name string, factory ProviderFactory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	if factory == nil {
+		panic("foundation: RegisterFactory factory is nil for " + name)
+	}
+	if _, exists := factories[name]; exists {
+		panic("foundation: RegisterFactory called twice for " + name)
+	}
+	factories[name] = factory
+}
+
+// Factories returns the names of the registered provider factories, sorted.
+func Factories() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegisterConfigured registers the providers listed in the `app.providers`
+// configuration, in order, using the factories registered with
+// RegisterFactory. Providers whose `plugins.<name>.enabled` setting is false
+// are skipped:
+//
+//	app:
+//	  providers: [cache, queue, websocket]
+//	plugins:
+//	  websocket:
+//	    enabled: false
+//
+// It only registers the configured providers once; Run calls it after
+// loading the configuration.
+func (app *Application) RegisterConfigured() error {
+	app.mu.RLock()
+	registered := app.configuredRegistered
+	app.mu.RUnlock()
+	if registered {
+		return nil
+	}
+
+	for _, name := range configuredProviders() {
+		if !pluginEnabled(name) {
+			app.Log().Info("Provider disabled by configuration", "provider", name)
+			continue
+		}
+
+		factoriesMu.RLock()
+		factory, ok := factories[name]
+		factoriesMu.RUnlock()
+		if !ok {
+			return fmt.Errorf("provider '%s' is configured but has no factory registered with RegisterFactory", name)
+		}
+
+		provider := factory()
+		var err error
+		if plugin, ok := provider.(foundation.PluginProvider); ok {
+			err = app.RegisterPlugin(plugin)
+		} else {
+			err = app.Register(provider)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to register configured provider '%s': %w", name, err)
+		}
+	}
+
+	app.mu.Lock()
+	app.configuredRegistered = true
+	app.mu.Unlock()
+	return nil
+}
+
+// configuredProviders reads `app.providers`, given as a YAML list or as a
+// comma-separated string (e.g. APP_PROVIDERS=cache,queue).
+func configuredProviders() []string {
+	var names []string
+	switch value := config.Get("app.providers").(type) {
+	case []string:
+		names = value
+	case []interface{}:
+		for _, name := range value {
+			names = append(names, fmt.Sprint(name))
+		}
+	case string:
+		names = strings.Split(value, ",")
+	}
+
+	providers := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			providers = append(providers, name)
+		}
+	}
+	return providers
+}
+
+// pluginEnabled reports whether `plugins.<name>.enabled` allows the plugin.
+// Plugins are enabled unless the setting is explicitly false.
+func pluginEnabled(name string) bool {
+	key := "plugins." + name + ".enabled"
+	if config.Get(key) == nil {
+		return true
+	}
+	return config.GetBool(key)
+}
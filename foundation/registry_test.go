@@ -0,0 +1,74 @@
+package foundation
+
+import (
+	"testing"
+
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	"github.com/stretchr/testify/assert"
+)
+
+// queueProvider is a plain provider created from a factory
+type queueProvider struct{}
+
+func (p *queueProvider) Register(app foundation.Application) error {
+	app.Bind("registry.queue", func() interface{} { return "queue" })
+	return nil
+}
+
+func (p *queueProvider) Boot(app foundation.Application) error { return nil }
+
+func init() {
+	RegisterFactory("registry-cache", func() ServiceProvider {
+		return &MockPlugin{name: "registry-cache", version: "1.0.0"}
+	})
+	RegisterFactory("registry-queue", func() ServiceProvider {
+		return &queueProvider{}
+	})
+	RegisterFactory("registry-websocket", func() ServiceProvider {
+		return &MockPlugin{name: "registry-websocket", version: "1.0.0"}
+	})
+}
+
+func TestRegisterConfigured_RegistersEnabledProviders(t *testing.T) {
+	t.Setenv("APP_PROVIDERS", "registry-cache, registry-queue,registry-websocket")
+	t.Setenv("PLUGINS_REGISTRY-WEBSOCKET_ENABLED", "false")
+
+	app := New(t.TempDir())
+	assert.NoError(t, app.LoadConfig())
+
+	assert.NoError(t, app.RegisterConfigured())
+	assert.NoError(t, app.RegisterConfigured()) // Only registers once
+
+	assert.Len(t, app.GetProviders(), 2)
+	assert.True(t, app.HasPlugin("registry-cache"))
+	assert.False(t, app.HasPlugin("registry-websocket"))
+	assert.True(t, app.Has("registry.queue"))
+}
+
+func TestRegisterConfigured_UnknownProvider(t *testing.T) {
+	t.Setenv("APP_PROVIDERS", "registry-missing")
+
+	app := New(t.TempDir())
+	assert.NoError(t, app.LoadConfig())
+
+	err := app.RegisterConfigured()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "provider 'registry-missing' is configured but has no factory")
+}
+
+func TestRegisterPlugin_DisabledByConfig(t *testing.T) {
+	t.Setenv("PLUGINS_REGISTRY-DISABLED_ENABLED", "false")
+
+	app := New(t.TempDir())
+	assert.NoError(t, app.LoadConfig())
+
+	assert.NoError(t, app.RegisterPlugin(&MockPlugin{name: "registry-disabled"}))
+	assert.False(t, app.HasPlugin("registry-disabled"))
+}
+
+func TestRegisterFactory_Duplicate(t *testing.T) {
+	assert.Contains(t, Factories(), "registry-cache")
+	assert.Panics(t, func() {
+		RegisterFactory("registry-cache", func() ServiceProvider { return &queueProvider{} })
+	})
+}
@@ -0,0 +1,275 @@
+package foundation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/donnigundala/dg-core/config"
+	contractHTTP "github.com/donnigundala/dg-core/contracts/http"
+)
+
+// TagServers is the container tag of the servers started by Run.
+const TagServers = "servers"
+
+// Runnable is a long-running server started by Run, such as http.HTTPServer.
+// It has the same method set as http.Runnable.
+type Runnable interface {
+	Start() error
+	Shutdown(ctx context.Context) error
+}
+
+// LoadConfig loads the .env file and every YAML file found in the base path
+// and the config path, followed by the overlays of the environment: the
+// .env.<env> file and the YAML files in config/<env>/. It only loads once, so
+// providers with `config` fields should call it before being registered; Run
+// calls it otherwise.
+func (app *Application) LoadConfig() error {
+	app.mu.RLock()
+	loaded := app.configLoaded
+	app.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	if err := config.LoadWithPaths(app.BasePath(), app.ConfigPath()); err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	// The environment may come from .env or the base configuration
+	if err := config.LoadEnvironment(app.Environment(), app.ConfigPath()); err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	app.mu.Lock()
+	app.configLoaded = true
+	app.mu.Unlock()
+	return nil
+}
+
+// Run loads the configuration, registers the configured providers (see
+// RegisterConfigured), boots the application and starts every server
+// bound in the container with the TagServers tag, along with the enabled
+// servers of the http.Manager bound to "http.manager" (see
+// http.ServiceProvider). It then blocks until ctx is
+// canceled, SIGINT or SIGTERM is received or a server fails, and shuts
+// everything down within the shutdown timeout: the servers are drained in
+// ShutdownPhaseDrain, before the other shutdown hooks, providers and the
+// container.
+//
+// Providers register their servers by tagging them:
+//
+//	app.Singleton("http.server", func() interface{} {
+//	    return http.NewHTTPServer(cfg, kernel)
+//	})
+//	app.Tag([]string{"http.server"}, foundation.TagServers)
+//
+// Run returns an error without serving when there are no servers, nil after a
+// signal, or the error that stopped the application combined with any
+// shutdown errors.
+func (app *Application) Run(ctx context.Context) error {
+	if err := app.LoadConfig(); err != nil {
+		return err
+	}
+
+	if err := app.RegisterConfigured(); err != nil {
+		return err
+	}
+
+	if err := app.Boot(); err != nil {
+		return fmt.Errorf("failed to boot application: %w", err)
+	}
+
+	servers, err := app.servers()
+	if err != nil {
+		return err
+	}
+	if len(servers) == 0 {
+		return fmt.Errorf("no servers to run: tag them with %q or register them with the http.Manager bound to %q",
+			TagServers, contractHTTP.ManagerKey)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger := app.Log()
+	wg := &sync.WaitGroup{}
+	errCh := make(chan error, len(servers))
+
+	for _, srv := range servers {
+		wg.Add(1)
+		go func(name string, s Runnable) {
+			defer wg.Done()
+			logger.Info("server started", "name", name)
+			if err := s.Start(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errCh <- fmt.Errorf("server %q failed: %w", name, err)
+			}
+		}(srv.name, srv.runner)
+	}
+
+	app.emit(Event{Name: EventServing})
+
+	// Wait for a shutdown signal (context cancellation) or a server error.
+	var runErr error
+	select {
+	case <-ctx.Done():
+		logger.Info("shutdown signal received, initiating graceful shutdown")
+	case runErr = <-errCh:
+		logger.Error("a server failed, initiating shutdown", "error", runErr)
+	}
+	stop()
+
+	app.shutdown.mu.Lock()
+	timeout := app.shutdown.timeout
+	app.shutdown.mu.Unlock()
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+
+	// Stop the servers first, so in-flight requests drain before the
+	// services they use are shut down.
+	app.OnShutdown("servers", func(ctx context.Context) error {
+		return shutdownServers(ctx, servers, wg)
+	}, WithShutdownPhase(ShutdownPhaseDrain))
+
+	report, err := app.ShutdownWithReport(shutdownCtx)
+	for _, hook := range report.Hooks {
+		logger.Debug("shutdown hook finished", "name", hook.Name, "duration", hook.Duration,
+			"timed_out", hook.TimedOut, "skipped", hook.Skipped)
+	}
+
+	logger.Info("application stopped", "duration", report.Duration)
+	return errors.Join(runErr, err)
+}
+
+// shutdownServers shuts the servers down concurrently and waits for them to
+// return from Start.
+func shutdownServers(ctx context.Context, servers []namedServer, started *sync.WaitGroup) error {
+	errs := make([]error, len(servers))
+	wg := &sync.WaitGroup{}
+	for i, srv := range servers {
+		wg.Add(1)
+		go func(i int, name string, s Runnable) {
+			defer wg.Done()
+			if err := s.Shutdown(ctx); err != nil {
+				errs[i] = fmt.Errorf("shutdown failed for server %q: %w", name, err)
+			}
+		}(i, srv.name, srv.runner)
+	}
+	wg.Wait()
+
+	stopped := make(chan struct{})
+	go func() {
+		started.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+	}
+
+	return errors.Join(errs...)
+}
+
+// namedServer is a server resolved for Run.
+type namedServer struct {
+	name   string
+	runner Runnable
+}
+
+// serverManager is the part of http.Manager used by Run.
+type serverManager interface {
+	RunAll(ctx context.Context) error
+	Enabled() []string
+}
+
+// managedServers runs the servers of a manager as a single Runnable.
+type managedServers struct {
+	manager serverManager
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+	err     error
+}
+
+func newManagedServers(manager serverManager) *managedServers {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &managedServers{manager: manager, ctx: ctx, cancel: cancel, done: make(chan struct{})}
+}
+
+// Start runs the servers until Shutdown is called or one of them fails.
+func (m *managedServers) Start() error {
+	defer close(m.done)
+	m.err = m.manager.RunAll(m.ctx)
+	if m.ctx.Err() != nil {
+		return nil // Stopped by Shutdown, which reports the errors
+	}
+	return m.err
+}
+
+// Shutdown stops the manager and waits for it to shut its servers down.
+func (m *managedServers) Shutdown(ctx context.Context) error {
+	m.cancel()
+	select {
+	case <-m.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	// Keep the shutdown errors, not the cancellation that stopped the manager
+	errs := []error{m.err}
+	if joined, ok := m.err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+	var shutdownErrs []error
+	for _, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			shutdownErrs = append(shutdownErrs, err)
+		}
+	}
+	return errors.Join(shutdownErrs...)
+}
+
+// servers resolves the servers tagged with TagServers, and the manager bound
+// to contractHTTP.ManagerKey when it has enabled servers.
+func (app *Application) servers() ([]namedServer, error) {
+	instances, err := app.Tagged(TagServers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve servers: %w", err)
+	}
+
+	servers := make([]namedServer, 0, len(instances))
+	for _, instance := range instances {
+		runner, ok := instance.(Runnable)
+		if !ok {
+			return nil, fmt.Errorf("server %T does not implement Runnable", instance)
+		}
+
+		name := fmt.Sprintf("%T", instance)
+		if named, ok := instance.(interface{ Name() string }); ok {
+			name = named.Name()
+		}
+		servers = append(servers, namedServer{name: name, runner: runner})
+	}
+
+	if !app.Has(contractHTTP.ManagerKey) {
+		return servers, nil
+	}
+	instance, err := app.Make(contractHTTP.ManagerKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve '%s': %w", contractHTTP.ManagerKey, err)
+	}
+	manager, ok := instance.(serverManager)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is a %T, not an http.Manager", contractHTTP.ManagerKey, instance)
+	}
+	if len(manager.Enabled()) > 0 {
+		servers = append(servers, namedServer{name: contractHTTP.ManagerKey, runner: newManagedServers(manager)})
+	}
+
+	return servers, nil
+}
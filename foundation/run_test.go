@@ -0,0 +1,187 @@
+package foundation
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	dghttp "github.com/donnigundala/dg-core/http"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeServer blocks in Start until it is shut down
+type fakeServer struct {
+	startErr error
+	started  chan struct{}
+	stopped  chan struct{}
+	once     sync.Once
+	events   *[]string
+	mu       *sync.Mutex
+}
+
+func newFakeServer(events *[]string, mu *sync.Mutex) *fakeServer {
+	return &fakeServer{
+		started: make(chan struct{}),
+		stopped: make(chan struct{}),
+		events:  events,
+		mu:      mu,
+	}
+}
+
+func (s *fakeServer) Start() error {
+	close(s.started)
+	if s.startErr != nil {
+		return s.startErr
+	}
+	<-s.stopped
+	return nil
+}
+
+func (s *fakeServer) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	*s.events = append(*s.events, "server")
+	s.mu.Unlock()
+	s.once.Do(func() { close(s.stopped) })
+	return nil
+}
+
+// serverProvider registers a server and records its own shutdown
+type serverProvider struct {
+	server *fakeServer
+}
+
+func (p *serverProvider) Register(app foundation.Application) error {
+	app.Instance("test.server", p.server)
+	app.Tag([]string{"test.server"}, TagServers)
+	return nil
+}
+
+func (p *serverProvider) Boot(app foundation.Application) error { return nil }
+
+func (p *serverProvider) Shutdown(app foundation.Application) error {
+	p.server.mu.Lock()
+	*p.server.events = append(*p.server.events, "provider")
+	p.server.mu.Unlock()
+	return nil
+}
+
+func TestRun_StopsOnContextCancel(t *testing.T) {
+	app := New(t.TempDir())
+	var events []string
+	server := newFakeServer(&events, &sync.Mutex{})
+	assert.NoError(t, app.Register(&serverProvider{server: server}))
+	serving := make(chan Event, 1)
+	app.On(EventServing, func(e Event) { serving <- e })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- app.Run(ctx) }()
+
+	select {
+	case <-server.started:
+	case <-time.After(time.Second):
+		t.Fatal("server was not started")
+	}
+	assert.True(t, app.IsBooted())
+	select {
+	case <-serving:
+	case <-time.After(time.Second):
+		t.Fatal("serving event was not published")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+
+	// Servers are drained before providers shut down
+	assert.Equal(t, []string{"server", "provider"}, events)
+}
+
+func TestRun_StopsOnServerFailure(t *testing.T) {
+	app := New(t.TempDir())
+	var events []string
+	server := newFakeServer(&events, &sync.Mutex{})
+	server.startErr = errors.New("address already in use")
+	assert.NoError(t, app.Register(&serverProvider{server: server}))
+
+	err := app.Run(context.Background())
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "address already in use")
+	assert.Equal(t, []string{"server", "provider"}, events)
+}
+
+func TestRun_RejectsNonRunnableServer(t *testing.T) {
+	app := New(t.TempDir())
+	app.Instance("not.a.server", "value")
+	app.Tag([]string{"not.a.server"}, TagServers)
+
+	err := app.Run(context.Background())
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "does not implement Runnable")
+}
+
+// managedServerProvider registers a server with the http.Manager on boot
+type managedServerProvider struct {
+	server *fakeServer
+}
+
+func (p *managedServerProvider) Register(app foundation.Application) error { return nil }
+
+func (p *managedServerProvider) Boot(app foundation.Application) error {
+	manager, err := app.Make(dghttp.ManagerKey)
+	if err != nil {
+		return err
+	}
+	manager.(*dghttp.Manager).Register("public", p.server)
+	return nil
+}
+
+func TestRun_StartsManagerServers(t *testing.T) {
+	app := New(t.TempDir())
+	var events []string
+	server := newFakeServer(&events, &sync.Mutex{})
+	assert.NoError(t, app.Register(&dghttp.ServiceProvider{
+		Options: []dghttp.ManagerOption{dghttp.WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))},
+	}))
+	assert.NoError(t, app.Register(&managedServerProvider{server: server}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- app.Run(ctx) }()
+
+	select {
+	case <-server.started:
+	case <-time.After(time.Second):
+		t.Fatal("manager server was not started")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+	assert.Equal(t, []string{"server"}, events)
+}
+
+func TestRun_RequiresServers(t *testing.T) {
+	app := New(t.TempDir())
+	assert.NoError(t, app.Register(&dghttp.ServiceProvider{}))
+
+	err := app.Run(context.Background())
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no servers to run")
+}
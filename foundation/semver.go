@@ -0,0 +1,275 @@
+package foundation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// version is a parsed semantic version. Missing minor and patch numbers are
+// treated as zero, so "2" and "2.0" both mean 2.0.0.
+type version struct {
+	major, minor, patch int
+	pre                 string
+}
+
+// parseVersion parses versions such as "1.2.3", "v1.2" or "2.0.0-beta.1".
+// Build metadata ("+build") is ignored.
+func parseVersion(s string) (version, error) {
+	v, _, err := parsePartialVersion(s)
+	return v, err
+}
+
+// parsePartialVersion parses a version and also returns how many of its
+// numeric parts were given, which ^, ~ and wildcards need.
+func parsePartialVersion(s string) (version, int, error) {
+	raw := s
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.Index(s, "+"); i >= 0 {
+		s = s[:i]
+	}
+
+	var v version
+	if i := strings.Index(s, "-"); i >= 0 {
+		s, v.pre = s[:i], s[i+1:]
+	}
+
+	parts := strings.Split(s, ".")
+	if s == "" || len(parts) > 3 {
+		return version{}, 0, fmt.Errorf("invalid version '%s'", raw)
+	}
+
+	numbers := [3]*int{&v.major, &v.minor, &v.patch}
+	given := 0
+	for i, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			break
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return version{}, 0, fmt.Errorf("invalid version '%s'", raw)
+		}
+		*numbers[i] = n
+		given++
+	}
+
+	return v, given, nil
+}
+
+// compare returns -1, 0 or 1 when v is lower than, equal to or greater than o.
+// A pre-release is lower than its release: 1.0.0-beta < 1.0.0.
+func (v version) compare(o version) int {
+	if cmp := v.compareRelease(o); cmp != 0 {
+		return cmp
+	}
+
+	switch {
+	case v.pre == o.pre:
+		return 0
+	case v.pre == "":
+		return 1
+	case o.pre == "":
+		return -1
+	default:
+		return comparePrerelease(v.pre, o.pre)
+	}
+}
+
+// compareRelease compares the major, minor and patch numbers only.
+func (v version) compareRelease(o version) int {
+	for _, d := range [3]int{v.major - o.major, v.minor - o.minor, v.patch - o.patch} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// comparePrerelease compares pre-release identifiers as semver §11 defines:
+// dot-separated identifiers are compared one by one, numerically when both
+// are numbers ("rc.9" < "rc.10"), and a number is lower than a word; when
+// all are equal, the version with fewer identifiers is lower.
+func comparePrerelease(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				if an < bn {
+					return -1
+				}
+				return 1
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		case as[i] != bs[i]:
+			if as[i] < bs[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// comparison is a single check such as ">=1.2.0".
+type comparison struct {
+	op string
+	v  version
+}
+
+func (c comparison) allows(v version) bool {
+	cmp := v.compare(c.v)
+	switch c.op {
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case "!=":
+		return cmp != 0
+	default:
+		return cmp == 0
+	}
+}
+
+// versionConstraint is a set of alternatives separated by "||", each of
+// which is a list of comparisons separated by commas or spaces that must all
+// hold.
+type versionConstraint struct {
+	alternatives [][]comparison
+}
+
+// parseConstraint parses constraints such as "^1.2", "~1.4.0", ">=2.0,<3",
+// "1.x" or "^1.0 || ^2.0". An empty constraint or "*" allows any version.
+func parseConstraint(s string) (versionConstraint, error) {
+	var c versionConstraint
+	for _, alternative := range strings.Split(s, "||") {
+		var comparisons []comparison
+		terms := strings.FieldsFunc(alternative, func(r rune) bool {
+			return r == ',' || r == ' '
+		})
+		for i := 0; i < len(terms); i++ {
+			term := terms[i]
+			// Allow a space between an operator and its version: ">= 2.0"
+			if strings.TrimLeft(term, "<>=!^~") == "" && i+1 < len(terms) {
+				i++
+				term += terms[i]
+			}
+
+			parsed, err := parseTerm(term)
+			if err != nil {
+				return versionConstraint{}, err
+			}
+			comparisons = append(comparisons, parsed...)
+		}
+		c.alternatives = append(c.alternatives, comparisons)
+	}
+	return c, nil
+}
+
+// parseTerm expands a single term into the comparisons it stands for.
+func parseTerm(term string) ([]comparison, error) {
+	if term == "*" || term == "x" || term == "X" {
+		return nil, nil
+	}
+
+	op := ""
+	for _, prefix := range []string{">=", "<=", "!=", "==", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(term, prefix) {
+			op, term = prefix, strings.TrimSpace(term[len(prefix):])
+			break
+		}
+	}
+
+	v, given, err := parsePartialVersion(term)
+	if err != nil {
+		return nil, err
+	}
+
+	// upper returns the first version above v with the given part bumped
+	upper := func(part int) version {
+		switch part {
+		case 0:
+			return version{major: v.major + 1}
+		case 1:
+			return version{major: v.major, minor: v.minor + 1}
+		default:
+			return version{major: v.major, minor: v.minor, patch: v.patch + 1}
+		}
+	}
+	rangeOf := func(part int) []comparison {
+		return []comparison{{">=", v}, {"<", upper(part)}}
+	}
+
+	switch op {
+	case "^":
+		// Allow changes that do not modify the left-most non-zero part
+		switch {
+		case v.major > 0 || given == 1:
+			return rangeOf(0), nil
+		case v.minor > 0 || given == 2:
+			return rangeOf(1), nil
+		default:
+			return rangeOf(2), nil
+		}
+	case "~":
+		// Allow patch changes, or minor changes if only a major is given
+		if given == 1 {
+			return rangeOf(0), nil
+		}
+		return rangeOf(1), nil
+	case "", "=", "==":
+		// A partial version such as "1.2" or "1.x" matches the whole range
+		if given == 0 {
+			return nil, nil
+		}
+		if given < 3 {
+			return rangeOf(given - 1), nil
+		}
+		return []comparison{{"=", v}}, nil
+	default:
+		return []comparison{{op, v}}, nil
+	}
+}
+
+// allows reports whether v satisfies the constraint. A pre-release only
+// satisfies an alternative that has a pre-release comparison on the same
+// major.minor.patch, so "^1.2" never admits "2.0.0-beta", while
+// ">=1.2.0-beta" admits "1.2.0-rc.1" but not "1.3.0-rc.1".
+func (c versionConstraint) allows(v version) bool {
+	for _, alternative := range c.alternatives {
+		ok := v.pre == ""
+		for _, cmp := range alternative {
+			if !cmp.allows(v) {
+				ok = false
+				break
+			}
+			if cmp.v.pre != "" && cmp.v.compareRelease(v) == 0 {
+				ok = true
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
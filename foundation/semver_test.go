@@ -0,0 +1,73 @@
+package foundation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionConstraint_Allows(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		allowed    bool
+	}{
+		{"^1.2", "1.2.0", true},
+		{"^1.2", "1.9.3", true},
+		{"^1.2", "1.1.9", false},
+		{"^1.2", "2.0.0", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"~1.4.0", "1.4.7", true},
+		{"~1.4.0", "1.5.0", false},
+		{">=2.0,<3", "2.5.1", true},
+		{">=2.0,<3", "3.0.0", false},
+		{">=2.0,<3", "1.9.9", false},
+		{">= 2.0, < 3", "2.0.0", true},
+		{"1.x", "1.7.0", true},
+		{"1.x", "2.0.0", false},
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "1.2.4", false},
+		{"!=1.0.0", "1.0.0", false},
+		{"^1.0 || ^2.0", "2.1.0", true},
+		{"^1.0 || ^2.0", "3.0.0", false},
+		{"*", "0.0.1", true},
+		{"", "5.0.0", true},
+		{"^1.0", "v1.3.0", true},
+		{">=1.0.0", "1.0.0-beta", false},
+		// Pre-releases only match comparisons with a pre-release on the same version
+		{"^1.2", "2.0.0-beta", false},
+		{"<2.0.0", "2.0.0-rc.1", false},
+		{"^1.2.0-beta", "1.2.0-rc.1", true},
+		{"^1.2.0-beta", "1.3.0-rc.1", false},
+		{"^1.2.0-beta", "1.4.0", true},
+		{"1.0.0-beta.2", "1.0.0-beta.2", true},
+		// Numeric identifiers compare numerically
+		{">1.0.0-rc.9", "1.0.0-rc.10", true},
+		{"<1.0.0-rc.10", "1.0.0-rc.9", true},
+		{">=1.0.0-alpha.1", "1.0.0-alpha", false},
+		{">1.0.0-alpha.1", "1.0.0-alpha.beta", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			c, err := parseConstraint(tt.constraint)
+			assert.NoError(t, err)
+
+			v, err := parseVersion(tt.version)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.allowed, c.allows(v))
+		})
+	}
+}
+
+func TestVersionConstraint_Invalid(t *testing.T) {
+	for _, constraint := range []string{"^a.b", ">=1.2.3.4", "~"} {
+		_, err := parseConstraint(constraint)
+		assert.Error(t, err, constraint)
+	}
+
+	_, err := parseVersion("latest")
+	assert.Error(t, err)
+}
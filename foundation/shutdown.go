@@ -2,19 +2,92 @@ package foundation
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"os/signal"
+	"sort"
 	"sync"
 	"syscall"
 	"time"
+
+	"github.com/donnigundala/dg-core/contracts/foundation"
 )
 
 // ShutdownHook is a function that is called during shutdown.
 type ShutdownHook func()
 
+// ShutdownFunc is a context-aware shutdown hook. The context carries the
+// deadline of the hook; a hook should return once it is done.
+type ShutdownFunc func(ctx context.Context) error
+
+// ShutdownPhase orders shutdown hooks: lower phases run first, and hooks of
+// the same phase run in reverse registration order (LIFO). Any value can be
+// used to run a hook between the named phases.
+type ShutdownPhase int
+
+const (
+	// ShutdownPhaseDrain stops accepting new work, e.g. draining HTTP servers.
+	ShutdownPhaseDrain ShutdownPhase = 100
+	// ShutdownPhaseWorkers stops background workers and queue consumers.
+	ShutdownPhaseWorkers ShutdownPhase = 200
+	// ShutdownPhaseDefault is the phase of hooks registered without one.
+	ShutdownPhaseDefault ShutdownPhase = 300
+	// ShutdownPhaseProviders shuts down providers implementing
+	// ShutdownProvider, in the reverse of their boot order.
+	ShutdownPhaseProviders ShutdownPhase = 400
+	// ShutdownPhaseStores closes databases, caches and other stores.
+	ShutdownPhaseStores ShutdownPhase = 500
+)
+
+// ShutdownOption configures a hook registered with OnShutdown.
+type ShutdownOption func(*shutdownHook)
+
+// WithShutdownPhase sets the phase a hook runs in.
+func WithShutdownPhase(phase ShutdownPhase) ShutdownOption {
+	return func(h *shutdownHook) {
+		h.phase = phase
+	}
+}
+
+// WithHookTimeout limits how long a hook may run. A hook that exceeds it is
+// abandoned and reported as timed out, and shutdown moves on.
+func WithHookTimeout(timeout time.Duration) ShutdownOption {
+	return func(h *shutdownHook) {
+		h.timeout = timeout
+	}
+}
+
+// ShutdownReport describes a completed shutdown.
+type ShutdownReport struct {
+	// Hooks lists every hook in the order it ran (or was skipped).
+	Hooks []HookReport `json:"hooks"`
+	// Duration is the total time the shutdown took.
+	Duration time.Duration `json:"duration"`
+}
+
+// HookReport describes how a single shutdown hook ran.
+type HookReport struct {
+	Name     string        `json:"name"`
+	Phase    ShutdownPhase `json:"phase"`
+	Duration time.Duration `json:"duration"`
+	Err      error         `json:"-"`
+	TimedOut bool          `json:"timed_out"`
+	Skipped  bool          `json:"skipped"`
+}
+
+// shutdownHook is a registered hook.
+type shutdownHook struct {
+	name    string
+	fn      ShutdownFunc
+	phase   ShutdownPhase
+	timeout time.Duration
+	order   int
+}
+
 // shutdownManager manages graceful shutdown.
 type shutdownManager struct {
-	hooks   []ShutdownHook
+	hooks   []*shutdownHook
 	mu      sync.Mutex
 	timeout time.Duration
 }
@@ -22,15 +95,52 @@ type shutdownManager struct {
 // newShutdownManager creates a new shutdown manager.
 func newShutdownManager() *shutdownManager {
 	return &shutdownManager{
-		hooks:   make([]ShutdownHook, 0),
+		hooks:   make([]*shutdownHook, 0),
 		timeout: 30 * time.Second, // Default 30 seconds
 	}
 }
 
-// RegisterShutdownHook registers a function to be called during shutdown.
+// clone returns a manager with the same hooks and timeout.
+func (m *shutdownManager) clone() *shutdownManager {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return &shutdownManager{
+		hooks:   append([]*shutdownHook(nil), m.hooks...),
+		timeout: m.timeout,
+	}
+}
+
+// RegisterShutdownHook registers a function to be called during shutdown,
+// in the default phase.
 func (a *Application) RegisterShutdownHook(hook ShutdownHook) {
+	a.shutdown.mu.Lock()
+	name := fmt.Sprintf("hook-%d", len(a.shutdown.hooks)+1)
+	a.shutdown.mu.Unlock()
+
+	a.OnShutdown(name, func(ctx context.Context) error {
+		hook()
+		return nil
+	})
+}
+
+// OnShutdown registers a named, context-aware shutdown hook.
+//
+// Example:
+//
+//	app.OnShutdown("queue-workers", func(ctx context.Context) error {
+//	    return workers.Stop(ctx)
+//	}, foundation.WithShutdownPhase(foundation.ShutdownPhaseWorkers),
+//	    foundation.WithHookTimeout(5*time.Second))
+func (a *Application) OnShutdown(name string, fn ShutdownFunc, opts ...ShutdownOption) {
+	hook := &shutdownHook{name: name, fn: fn, phase: ShutdownPhaseDefault}
+	for _, opt := range opts {
+		opt(hook)
+	}
+
 	a.shutdown.mu.Lock()
 	defer a.shutdown.mu.Unlock()
+	hook.order = len(a.shutdown.hooks)
 	a.shutdown.hooks = append(a.shutdown.hooks, hook)
 }
 
@@ -41,24 +151,130 @@ func (a *Application) SetShutdownTimeout(timeout time.Duration) {
 	a.shutdown.timeout = timeout
 }
 
-// Shutdown executes all registered shutdown hooks.
+// Shutdown runs the shutdown hooks phase by phase, including the providers
+// implementing ShutdownProvider in ShutdownPhaseProviders, and finally
+// disposes the container, closing every singleton it built that implements
+// io.Closer or Shutdown(ctx) error.
+//
+// Every failure is aggregated into the returned error. Once ctx is done the
+// remaining hooks are skipped and the error includes ctx.Err().
 func (a *Application) Shutdown(ctx context.Context) error {
+	_, err := a.ShutdownWithReport(ctx)
+	return err
+}
+
+// ShutdownWithReport is like Shutdown, and also reports how long each hook
+// took and whether it failed, timed out or was skipped.
+func (a *Application) ShutdownWithReport(ctx context.Context) (ShutdownReport, error) {
+	start := time.Now()
+	a.emit(Event{Name: EventShuttingDown})
+	hooks := a.shutdownHooks()
+
+	report := ShutdownReport{Hooks: make([]HookReport, 0, len(hooks))}
+	var errs []error
+	for _, hook := range hooks {
+		result := runShutdownHook(ctx, hook)
+		report.Hooks = append(report.Hooks, result)
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	report.Duration = time.Since(start)
+	err := errors.Join(errs...)
+	a.emit(Event{Name: EventTerminated, Duration: report.Duration, Err: err})
+
+	return report, err
+}
+
+// shutdownHooks returns the registered hooks, the provider hooks and the
+// disposal of the container in the order they run.
+func (a *Application) shutdownHooks() []*shutdownHook {
 	a.shutdown.mu.Lock()
-	hooks := make([]ShutdownHook, len(a.shutdown.hooks))
+	hooks := make([]*shutdownHook, len(a.shutdown.hooks))
 	copy(hooks, a.shutdown.hooks)
 	a.shutdown.mu.Unlock()
 
-	// Execute hooks in reverse order (LIFO)
-	for i := len(hooks) - 1; i >= 0; i-- {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-			hooks[i]()
+	// Providers are added in boot order and therefore run in reverse
+	for i, provider := range a.GetProviders() {
+		hook, ok := provider.(foundation.ShutdownProvider)
+		if !ok {
+			continue
+		}
+		hooks = append(hooks, &shutdownHook{
+			name:  "provider:" + providerName(provider),
+			phase: ShutdownPhaseProviders,
+			order: i,
+			fn: func(ctx context.Context) error {
+				if err := hook.Shutdown(a); err != nil {
+					return fmt.Errorf("provider shutdown failed: %w", err)
+				}
+				return nil
+			},
+		})
+	}
+
+	sort.SliceStable(hooks, func(i, j int) bool {
+		if hooks[i].phase != hooks[j].phase {
+			return hooks[i].phase < hooks[j].phase
+		}
+		return hooks[i].order > hooks[j].order
+	})
+
+	// Close container-managed singletons in reverse creation order
+	return append(hooks, &shutdownHook{
+		name:  "container",
+		phase: ShutdownPhaseStores,
+		fn: func(ctx context.Context) error {
+			if err := a.Dispose(ctx); err != nil {
+				return fmt.Errorf("failed to dispose container: %w", err)
+			}
+			return nil
+		},
+	})
+}
+
+// runShutdownHook runs a hook within its timeout, recovering from panics.
+func runShutdownHook(ctx context.Context, hook *shutdownHook) HookReport {
+	result := HookReport{Name: hook.name, Phase: hook.phase}
+	if ctx.Err() != nil {
+		result.Skipped = true
+		return result
+	}
+
+	hookCtx := ctx
+	if hook.timeout > 0 {
+		var cancel context.CancelFunc
+		hookCtx, cancel = context.WithTimeout(ctx, hook.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+		done <- hook.fn(hookCtx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			result.Err = fmt.Errorf("shutdown hook %q failed: %w", hook.name, err)
 		}
+	case <-hookCtx.Done():
+		result.TimedOut = true
+		result.Err = fmt.Errorf("shutdown hook %q timed out: %w", hook.name, hookCtx.Err())
 	}
+	result.Duration = time.Since(start)
 
-	return nil
+	return result
 }
 
 // WaitForShutdown blocks until a shutdown signal is received.
@@ -0,0 +1,102 @@
+package foundation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShutdown_RunsPhasesInOrder(t *testing.T) {
+	app := New("/tmp/test")
+	var order []string
+	record := func(name string) ShutdownFunc {
+		return func(ctx context.Context) error {
+			order = append(order, name)
+			return nil
+		}
+	}
+
+	app.OnShutdown("stores", record("stores"), WithShutdownPhase(ShutdownPhaseStores))
+	app.RegisterShutdownHook(func() { order = append(order, "legacy-1") })
+	app.OnShutdown("drain", record("drain"), WithShutdownPhase(ShutdownPhaseDrain))
+	app.RegisterShutdownHook(func() { order = append(order, "legacy-2") })
+	app.OnShutdown("workers", record("workers"), WithShutdownPhase(ShutdownPhaseWorkers))
+
+	report, err := app.ShutdownWithReport(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"drain", "workers", "legacy-2", "legacy-1", "stores"}, order)
+
+	// The container is always disposed last
+	assert.Len(t, report.Hooks, 6)
+	assert.Equal(t, "container", report.Hooks[5].Name)
+}
+
+func TestShutdown_AggregatesErrors(t *testing.T) {
+	app := New("/tmp/test")
+	errFlush := errors.New("flush failed")
+	errClose := errors.New("close failed")
+	ran := false
+
+	app.OnShutdown("flush", func(ctx context.Context) error { return errFlush })
+	app.OnShutdown("close", func(ctx context.Context) error { return errClose }, WithShutdownPhase(ShutdownPhaseStores))
+	app.OnShutdown("after-failure", func(ctx context.Context) error {
+		ran = true
+		return nil
+	}, WithShutdownPhase(ShutdownPhaseStores+1))
+	app.OnShutdown("panics", func(ctx context.Context) error { panic("boom") })
+
+	err := app.Shutdown(context.Background())
+
+	assert.ErrorIs(t, err, errFlush)
+	assert.ErrorIs(t, err, errClose)
+	assert.Contains(t, err.Error(), `shutdown hook "panics" failed: panic: boom`)
+	assert.True(t, ran)
+}
+
+func TestShutdown_HookTimeout(t *testing.T) {
+	app := New("/tmp/test")
+	ran := false
+
+	app.OnShutdown("hangs", func(ctx context.Context) error {
+		select {} // Ignores its context
+	}, WithHookTimeout(20*time.Millisecond))
+	app.OnShutdown("next", func(ctx context.Context) error {
+		ran = true
+		return nil
+	}, WithShutdownPhase(ShutdownPhaseStores))
+
+	report, err := app.ShutdownWithReport(context.Background())
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, ran)
+	assert.True(t, report.Hooks[0].TimedOut)
+	assert.GreaterOrEqual(t, report.Hooks[0].Duration, 20*time.Millisecond)
+}
+
+func TestShutdown_SkipsHooksAfterDeadline(t *testing.T) {
+	app := New("/tmp/test")
+	ran := false
+
+	app.OnShutdown("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}, WithShutdownPhase(ShutdownPhaseDrain))
+	app.OnShutdown("skipped", func(ctx context.Context) error {
+		ran = true
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	report, err := app.ShutdownWithReport(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, ran)
+	assert.True(t, report.Hooks[1].Skipped)
+	assert.True(t, report.Hooks[2].Skipped) // container
+}
@@ -2,7 +2,10 @@ package http
 
 import (
 	"net/http"
+	"reflect"
 	"regexp"
+	"runtime"
+	"sort"
 	"strings"
 
 	contractHTTP "github.com/donnigundala/dg-core/contracts/http"
@@ -90,6 +93,27 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	handler.ServeHTTP(w, req)
 }
 
+// Routes returns the registered routes, sorted by path and method, with the
+// global middleware followed by the group and route middleware.
+func (r *Router) Routes() []contractHTTP.RouteInfo {
+	routes := make([]contractHTTP.RouteInfo, 0, len(r.routes))
+	for _, route := range r.routes {
+		var middleware []string
+		for _, mw := range append(append([]func(http.Handler) http.Handler{}, r.middleware...), route.middleware...) {
+			middleware = append(middleware, middlewareName(mw))
+		}
+
+		routes = append(routes, contractHTTP.RouteInfo{
+			Method:     route.method,
+			Path:       route.path,
+			Name:       route.name,
+			Middleware: middleware,
+		})
+	}
+	sortRoutes(routes)
+	return routes
+}
+
 // addRoute adds a route to the router, considering current groups.
 func (r *Router) addRoute(method, path string, handler contractHTTP.HandlerFunc) *Route {
 	fullPath := path
@@ -176,3 +200,49 @@ func (r *Router) match(method, path string) (*Route, map[string]string) {
 
 	return nil, nil
 }
+
+// sortRoutes sorts routes by path, then method.
+func sortRoutes(routes []contractHTTP.RouteInfo) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+}
+
+// middlewareName returns the name of the function that created a middleware,
+// e.g. "http.ScopeMiddleware" for the closure returned by ScopeMiddleware.
+func middlewareName(mw func(http.Handler) http.Handler) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(mw).Pointer())
+	if fn == nil {
+		return "anonymous"
+	}
+
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	// Drop the suffixes of closures, e.g. ".func1" or ".func2.1"
+	for {
+		i := strings.LastIndex(name, ".")
+		if i < 0 || !(strings.HasPrefix(name[i+1:], "func") || isDigits(name[i+1:])) {
+			break
+		}
+		name = name[:i]
+	}
+	return name
+}
+
+// isDigits reports whether s is a non-empty string of digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -10,12 +10,13 @@ import (
 type ginRoute struct {
 	path   string
 	method string
+	names  map[string]string
 }
 
-// Name sets the route name (not used in Gin, but satisfies interface).
+// Name sets the route name. Gin doesn't support named routes directly, so
+// the name is only kept for route listings.
 func (r *ginRoute) Name(name string) contractHTTP.Route {
-	// Gin doesn't support named routes directly
-	// This is a no-op for compatibility
+	r.names[r.method+" "+r.path] = name
 	return r
 }
 
@@ -2,6 +2,8 @@ package http
 
 import (
 	"net/http"
+	"path"
+	"strings"
 
 	contractHTTP "github.com/donnigundala/dg-core/contracts/http"
 	"github.com/gin-gonic/gin"
@@ -10,6 +12,7 @@ import (
 // GinRouter is a Gin-based implementation of the Router interface.
 type GinRouter struct {
 	engine *gin.Engine
+	names  map[string]string
 }
 
 // NewGinRouter creates a new Gin-based router.
@@ -22,37 +25,38 @@ func NewGinRouter() *GinRouter {
 
 	return &GinRouter{
 		engine: engine,
+		names:  make(map[string]string),
 	}
 }
 
 // Get registers a GET route.
 func (g *GinRouter) Get(path string, handler contractHTTP.HandlerFunc) contractHTTP.Route {
 	g.engine.GET(path, gin.WrapF(handler))
-	return &ginRoute{path: path, method: "GET"}
+	return g.route("GET", path)
 }
 
 // Post registers a POST route.
 func (g *GinRouter) Post(path string, handler contractHTTP.HandlerFunc) contractHTTP.Route {
 	g.engine.POST(path, gin.WrapF(handler))
-	return &ginRoute{path: path, method: "POST"}
+	return g.route("POST", path)
 }
 
 // Put registers a PUT route.
 func (g *GinRouter) Put(path string, handler contractHTTP.HandlerFunc) contractHTTP.Route {
 	g.engine.PUT(path, gin.WrapF(handler))
-	return &ginRoute{path: path, method: "PUT"}
+	return g.route("PUT", path)
 }
 
 // Patch registers a PATCH route.
 func (g *GinRouter) Patch(path string, handler contractHTTP.HandlerFunc) contractHTTP.Route {
 	g.engine.PATCH(path, gin.WrapF(handler))
-	return &ginRoute{path: path, method: "PATCH"}
+	return g.route("PATCH", path)
 }
 
 // Delete registers a DELETE route.
 func (g *GinRouter) Delete(path string, handler contractHTTP.HandlerFunc) contractHTTP.Route {
 	g.engine.DELETE(path, gin.WrapF(handler))
-	return &ginRoute{path: path, method: "DELETE"}
+	return g.route("DELETE", path)
 }
 
 // Group creates a route group.
@@ -69,6 +73,7 @@ func (g *GinRouter) Group(attributes contractHTTP.GroupAttributes, callback func
 	groupRouter := &ginGroupRouter{
 		group:  ginGroup,
 		engine: g.engine,
+		names:  g.names,
 	}
 
 	// Execute callback with group router
@@ -87,6 +92,26 @@ func (g *GinRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	g.engine.ServeHTTP(w, r)
 }
 
+// Routes returns the registered routes, sorted by path and method. Gin does
+// not expose the middleware of a route, so it is not listed.
+func (g *GinRouter) Routes() []contractHTTP.RouteInfo {
+	routes := make([]contractHTTP.RouteInfo, 0, len(g.engine.Routes()))
+	for _, route := range g.engine.Routes() {
+		routes = append(routes, contractHTTP.RouteInfo{
+			Method: route.Method,
+			Path:   route.Path,
+			Name:   g.names[route.Method+" "+route.Path],
+		})
+	}
+	sortRoutes(routes)
+	return routes
+}
+
+// route creates the Route returned for a registered route.
+func (g *GinRouter) route(method, path string) *ginRoute {
+	return &ginRoute{path: path, method: method, names: g.names}
+}
+
 // Engine returns the underlying Gin engine for advanced usage.
 func (g *GinRouter) Engine() *gin.Engine {
 	return g.engine
@@ -105,31 +130,41 @@ func ginMiddlewareAdapter(mw func(http.Handler) http.Handler) gin.HandlerFunc {
 type ginGroupRouter struct {
 	group  *gin.RouterGroup
 	engine *gin.Engine
+	names  map[string]string
+}
+
+// route creates the Route returned for a route registered in the group.
+func (g *ginGroupRouter) route(method, relativePath string) *ginRoute {
+	fullPath := path.Join(g.group.BasePath(), relativePath)
+	if strings.HasSuffix(relativePath, "/") && !strings.HasSuffix(fullPath, "/") {
+		fullPath += "/"
+	}
+	return &ginRoute{path: fullPath, method: method, names: g.names}
 }
 
 func (g *ginGroupRouter) Get(path string, handler contractHTTP.HandlerFunc) contractHTTP.Route {
 	g.group.GET(path, gin.WrapF(handler))
-	return &ginRoute{path: path, method: "GET"}
+	return g.route("GET", path)
 }
 
 func (g *ginGroupRouter) Post(path string, handler contractHTTP.HandlerFunc) contractHTTP.Route {
 	g.group.POST(path, gin.WrapF(handler))
-	return &ginRoute{path: path, method: "POST"}
+	return g.route("POST", path)
 }
 
 func (g *ginGroupRouter) Put(path string, handler contractHTTP.HandlerFunc) contractHTTP.Route {
 	g.group.PUT(path, gin.WrapF(handler))
-	return &ginRoute{path: path, method: "PUT"}
+	return g.route("PUT", path)
 }
 
 func (g *ginGroupRouter) Patch(path string, handler contractHTTP.HandlerFunc) contractHTTP.Route {
 	g.group.PATCH(path, gin.WrapF(handler))
-	return &ginRoute{path: path, method: "PATCH"}
+	return g.route("PATCH", path)
 }
 
 func (g *ginGroupRouter) Delete(path string, handler contractHTTP.HandlerFunc) contractHTTP.Route {
 	g.group.DELETE(path, gin.WrapF(handler))
-	return &ginRoute{path: path, method: "DELETE"}
+	return g.route("DELETE", path)
 }
 
 func (g *ginGroupRouter) Group(attributes contractHTTP.GroupAttributes, callback func(contractHTTP.Router)) {
@@ -142,6 +177,7 @@ func (g *ginGroupRouter) Group(attributes contractHTTP.GroupAttributes, callback
 	groupRouter := &ginGroupRouter{
 		group:  nestedGroup,
 		engine: g.engine,
+		names:  g.names,
 	}
 
 	callback(groupRouter)
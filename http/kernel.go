@@ -17,10 +17,11 @@ type Kernel struct {
 // NewKernel creates a new Kernel instance.
 func NewKernel(app foundation.Application, router contractHTTP.Router) *Kernel {
 	return &Kernel{
-		app:        app,
-		router:     router,
+		app:    app,
+		router: router,
 		middleware: []func(http.Handler) http.Handler{
-			// Default global middleware can be added here
+			// Answer 503 while the application is down for maintenance
+			MaintenanceMiddleware(app),
 		},
 	}
 }
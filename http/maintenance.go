@@ -0,0 +1,135 @@
+package http
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/donnigundala/dg-core/contracts/foundation"
+)
+
+// MaintenanceCookie is the name of the cookie that bypasses maintenance mode.
+const MaintenanceCookie = "dg_maintenance_bypass"
+
+// DefaultMaintenanceExcept lists the paths that stay reachable while the
+// application is down, so orchestrators keep seeing it as healthy.
+var DefaultMaintenanceExcept = []string{"/health", "/healthz", "/livez", "/readyz"}
+
+// MaintenanceMiddleware answers 503 Service Unavailable while the application
+// is in maintenance mode (see foundation.Application.Down), with a Retry-After
+// header when the mode sets one. It lets through:
+//
+//   - the except paths, DefaultMaintenanceExcept when none are given; a path
+//     ending with "*" matches every path with that prefix;
+//   - clients whose remote address is in the mode's AllowedIPs;
+//   - clients holding the bypass cookie, which visiting /<secret> sets before
+//     redirecting to /.
+//
+// The remote address is taken from the connection, so behind a proxy the
+// middleware must run after one that rewrites RemoteAddr from trusted headers.
+// Applications that do not implement foundation.MaintenanceProvider are never down.
+func MaintenanceMiddleware(app foundation.Application, except ...string) func(http.Handler) http.Handler {
+	if len(except) == 0 {
+		except = DefaultMaintenanceExcept
+	}
+
+	return func(next http.Handler) http.Handler {
+		provider, ok := app.(foundation.MaintenanceProvider)
+		if !ok {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mode, down := provider.Maintenance()
+			if !down || matchesPath(r.URL.Path, except) || allowedIP(r.RemoteAddr, mode.AllowedIPs) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if mode.Secret != "" {
+				token := bypassToken(mode)
+				if r.URL.Path == "/"+mode.Secret {
+					http.SetCookie(w, &http.Cookie{
+						Name:     MaintenanceCookie,
+						Value:    token,
+						Path:     "/",
+						Expires:  time.Now().Add(12 * time.Hour),
+						HttpOnly: true,
+						Secure:   r.TLS != nil,
+						SameSite: http.SameSiteLaxMode,
+					})
+					http.Redirect(w, r, "/", http.StatusFound)
+					return
+				}
+
+				if cookie, err := r.Cookie(MaintenanceCookie); err == nil &&
+					subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(token)) == 1 {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			message := mode.Message
+			if message == "" {
+				message = http.StatusText(http.StatusServiceUnavailable)
+			}
+			if mode.RetryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(mode.RetryAfter))
+			}
+			http.Error(w, message, http.StatusServiceUnavailable)
+		})
+	}
+}
+
+// bypassToken derives the cookie value from the secret, so the cookie does
+// not reveal it and stops working once the application goes down again.
+func bypassToken(mode foundation.MaintenanceMode) string {
+	sum := sha256.Sum256([]byte(mode.Secret + "|" + mode.Since.UTC().Format(time.RFC3339Nano)))
+	return hex.EncodeToString(sum[:])
+}
+
+// matchesPath reports whether the path is one of the patterns.
+func matchesPath(path string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(path, prefix) {
+				return true
+			}
+		} else if path == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedIP reports whether the remote address matches one of the IPs or CIDRs.
+func allowedIP(remoteAddr string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return false
+	}
+
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	for _, entry := range allowed {
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			if network.Contains(ip) {
+				return true
+			}
+		} else if allowedIP := net.ParseIP(entry); allowedIP != nil && allowedIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	coreFoundation "github.com/donnigundala/dg-core/foundation"
+	dghttp "github.com/donnigundala/dg-core/http"
+)
+
+func newMaintenanceHandler(t *testing.T, mode *foundation.MaintenanceMode) http.Handler {
+	t.Helper()
+
+	app := coreFoundation.New(t.TempDir())
+	if mode != nil {
+		if err := app.Down(*mode); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	return dghttp.MaintenanceMiddleware(app)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func serve(handler http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+func TestMaintenanceMiddleware_Live(t *testing.T) {
+	handler := newMaintenanceHandler(t, nil)
+
+	w := serve(handler, httptest.NewRequest(http.MethodGet, "/", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected 200, got %d", w.Code)
+	}
+}
+
+func TestMaintenanceMiddleware_Down(t *testing.T) {
+	handler := newMaintenanceHandler(t, &foundation.MaintenanceMode{Message: "Back soon", RetryAfter: 120})
+
+	w := serve(handler, httptest.NewRequest(http.MethodGet, "/users", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected 503, got %d", w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "120" {
+		t.Errorf("Expected Retry-After 120, got %q", got)
+	}
+	if body := w.Body.String(); body != "Back soon\n" {
+		t.Errorf("Expected maintenance message, got %q", body)
+	}
+
+	// Health endpoints stay reachable
+	for _, path := range []string{"/health", "/healthz", "/livez", "/readyz"} {
+		w := serve(handler, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusOK {
+			t.Errorf("Expected %s to return 200, got %d", path, w.Code)
+		}
+	}
+}
+
+func TestMaintenanceMiddleware_AllowedIPs(t *testing.T) {
+	handler := newMaintenanceHandler(t, &foundation.MaintenanceMode{AllowedIPs: []string{"10.0.0.0/8", "192.168.1.7"}})
+
+	tests := map[string]int{
+		"10.1.2.3:4000":    http.StatusOK,
+		"192.168.1.7:4000": http.StatusOK,
+		"192.168.1.8:4000": http.StatusServiceUnavailable,
+	}
+	for addr, want := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = addr
+		if w := serve(handler, req); w.Code != want {
+			t.Errorf("Expected %d for %s, got %d", want, addr, w.Code)
+		}
+	}
+}
+
+func TestMaintenanceMiddleware_SecretBypass(t *testing.T) {
+	handler := newMaintenanceHandler(t, &foundation.MaintenanceMode{Secret: "let-me-in"})
+
+	w := serve(handler, httptest.NewRequest(http.MethodGet, "/let-me-in", nil))
+	if w.Code != http.StatusFound {
+		t.Fatalf("Expected a redirect, got %d", w.Code)
+	}
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != dghttp.MaintenanceCookie {
+		t.Fatalf("Expected the bypass cookie, got %v", cookies)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.AddCookie(cookies[0])
+	if w := serve(handler, req); w.Code != http.StatusOK {
+		t.Errorf("Expected the cookie to bypass maintenance, got %d", w.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.AddCookie(&http.Cookie{Name: dghttp.MaintenanceCookie, Value: "let-me-in"})
+	if w := serve(handler, req); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected a forged cookie to be rejected, got %d", w.Code)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -114,6 +115,21 @@ func (m *Manager) Disable(name string) error {
 	return nil
 }
 
+// Enabled returns the names of the enabled servers, sorted.
+func (m *Manager) Enabled() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var names []string
+	for name, srv := range m.servers {
+		if srv.Enabled {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 // RunAll starts all enabled servers concurrently and blocks until the context is canceled or a server fails.
 func (m *Manager) RunAll(ctx context.Context) error {
 	return m.runServers(ctx)
@@ -1,9 +1,12 @@
 package http
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 
+	dgcontainer "github.com/donnigundala/dg-core/container"
+	"github.com/donnigundala/dg-core/contracts/container"
 	"github.com/donnigundala/dg-core/ctxutil"
 )
 
@@ -31,3 +34,31 @@ func RequestIDMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// ScopeMiddleware opens a container scope for each request and disposes it once the request is done.
+// The scope is stored in the request context and also holds the request itself (under the "request" key
+// and by type), so scoped services (e.g. a unit of work or a tenant-aware repository) can be resolved from handlers:
+//
+//	scope, _ := ctxutil.ContainerFromContext(r.Context())
+//	uow, err := scope.Make("uow")
+func ScopeMiddleware(c container.Container) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scope := c.NewScope()
+
+			// Dispose even if the request context has been canceled by then.
+			defer func() {
+				ctx := context.WithoutCancel(r.Context())
+				if err := scope.Dispose(ctx); err != nil {
+					ctxutil.LoggerFromContext(ctx).Error("failed to dispose request scope", "error", err)
+				}
+			}()
+
+			r = r.WithContext(ctxutil.WithContainer(r.Context(), scope))
+			scope.Instance("request", r)
+			dgcontainer.InstanceType(scope, r)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/donnigundala/dg-core/container"
+	"github.com/donnigundala/dg-core/ctxutil"
+	dghttp "github.com/donnigundala/dg-core/http"
+)
+
+type requestCounter struct {
+	Path string
+}
+
+func TestScopeMiddleware(t *testing.T) {
+	c := container.NewContainer()
+	c.Scoped("counter", func(r *http.Request) *requestCounter {
+		return &requestCounter{Path: r.URL.Path}
+	})
+
+	var seen []*requestCounter
+	handler := dghttp.ScopeMiddleware(c)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scope, ok := ctxutil.ContainerFromContext(r.Context())
+		if !ok {
+			t.Fatal("Expected a scope in the request context")
+		}
+
+		first, err := container.Resolve[*requestCounter](scope, "counter")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		second, _ := container.Resolve[*requestCounter](scope, "counter")
+		if first != second {
+			t.Error("Expected the same instance within a request")
+		}
+		seen = append(seen, first)
+	}))
+
+	for _, path := range []string{"/a", "/b"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	if len(seen) != 2 || seen[0] == seen[1] {
+		t.Fatal("Expected a different instance per request")
+	}
+	if seen[0].Path != "/a" || seen[1].Path != "/b" {
+		t.Errorf("Expected the request to be resolvable from the scope, got %s and %s", seen[0].Path, seen[1].Path)
+	}
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	contractHTTP "github.com/donnigundala/dg-core/contracts/http"
+)
+
+// ManagerKey is the container key the ServiceProvider binds the Manager to.
+const ManagerKey = contractHTTP.ManagerKey
+
+// ServiceProvider binds a Manager to the container under ManagerKey, where
+// the serve command and Application.Run find it. Providers register their
+// servers with it on boot:
+//
+//	manager, _ := app.Make(http.ManagerKey)
+//	manager.(*http.Manager).Register("public", http.NewHTTPServer(cfg, router))
+type ServiceProvider struct {
+	// Options configure the manager.
+	Options []ManagerOption
+}
+
+// Register binds the manager.
+func (p *ServiceProvider) Register(app foundation.Application) error {
+	app.Singleton(ManagerKey, func() interface{} {
+		return NewManager(p.Options...)
+	})
+	return nil
+}
+
+// Boot does nothing; the manager is started by the serve command or
+// Application.Run.
+func (p *ServiceProvider) Boot(app foundation.Application) error {
+	return nil
+}
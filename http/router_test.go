@@ -107,3 +107,45 @@ func TestRouter_Middleware(t *testing.T) {
 		t.Errorf("Expected X-Test header to be 'Passed', got '%s'", w.Header().Get("X-Test"))
 	}
 }
+
+func TestRouter_Routes(t *testing.T) {
+	noop := func(w http.ResponseWriter, r *http.Request) {}
+
+	for name, router := range map[string]contractHTTP.Router{
+		"gin":     dghttp.NewRouter(),
+		"default": dghttp.NewDefaultRouter(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			router.Get("/users", noop).Name("users.index")
+			router.Group(contractHTTP.GroupAttributes{Prefix: "/admin"}, func(r contractHTTP.Router) {
+				r.Post("/users", noop).Name("admin.users.store")
+			})
+
+			routes := router.(contractHTTP.RouteLister).Routes()
+			if len(routes) != 2 {
+				t.Fatalf("Expected 2 routes, got %d", len(routes))
+			}
+
+			want := contractHTTP.RouteInfo{Method: http.MethodPost, Path: "/admin/users", Name: "admin.users.store"}
+			if got := routes[0]; got.Method != want.Method || got.Path != want.Path || got.Name != want.Name {
+				t.Errorf("Expected %+v, got %+v", want, got)
+			}
+			if got := routes[1]; got.Path != "/users" || got.Name != "users.index" {
+				t.Errorf("Expected the users.index route, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestRouter_RoutesMiddleware(t *testing.T) {
+	router := dghttp.NewDefaultRouter()
+	router.Use(dghttp.RequestIDMiddleware)
+	router.Get("/users", func(w http.ResponseWriter, r *http.Request) {}).
+		Middleware(dghttp.MaintenanceMiddleware(nil))
+
+	routes := router.(contractHTTP.RouteLister).Routes()
+	want := []string{"http.RequestIDMiddleware", "http.MaintenanceMiddleware"}
+	if got := routes[0].Middleware; len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Expected middleware %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,70 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func at(value string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", value, time.UTC)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// TestParseCron_Next tests the next run time of cron expressions.
+func TestParseCron_Next(t *testing.T) {
+	tests := []struct {
+		expr string
+		from string
+		want string
+	}{
+		{"* * * * *", "2025-03-10 10:15", "2025-03-10 10:16"},
+		{"*/15 * * * *", "2025-03-10 10:15", "2025-03-10 10:30"},
+		{"0 2 * * *", "2025-03-10 10:15", "2025-03-11 02:00"},
+		{"30 9-17 * * mon-fri", "2025-03-14 17:45", "2025-03-17 09:30"},
+		{"0 0 1 jan,jul *", "2025-03-10 10:15", "2025-07-01 00:00"},
+		{"0 0 * * 7", "2025-03-10 10:15", "2025-03-16 00:00"},
+		{"@monthly", "2025-12-31 23:59", "2026-01-01 00:00"},
+		// Day of month or day of week, when both are restricted
+		{"0 0 13 * fri", "2025-03-10 10:15", "2025-03-13 00:00"},
+		{"0 0 29 2 *", "2025-03-10 10:15", "2028-02-29 00:00"},
+		// A field starting with * is unrestricted, even with a step
+		{"0 0 */2 * mon", "2025-03-10 10:15", "2025-03-17 00:00"},
+		{"0 0 1 * */2", "2025-03-10 10:15", "2025-04-01 00:00"},
+	}
+
+	for _, tt := range tests {
+		cron, err := parseCron(tt.expr)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", tt.expr, err)
+		}
+		if got := cron.next(at(tt.from)); !got.Equal(at(tt.want)) {
+			t.Errorf("%s from %s: expected %s, got %s", tt.expr, tt.from, tt.want, got.Format("2006-01-02 15:04"))
+		}
+	}
+}
+
+// TestParseCron_Invalid tests that invalid expressions are rejected.
+func TestParseCron_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "5-1 * * * *", "*/0 * * * *", "* * * foo *"} {
+		if _, err := parseCron(expr); err == nil {
+			t.Errorf("Expected %q to be invalid", expr)
+		}
+	}
+}
+
+// TestParseCron_NextInTimezone tests that schedules follow the clock of their time zone.
+func TestParseCron_NextInTimezone(t *testing.T) {
+	location, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	cron, _ := parseCron("0 * * * *")
+	next := cron.next(time.Date(2025, 3, 10, 10, 15, 0, 0, location))
+	if want := time.Date(2025, 3, 10, 11, 0, 0, 0, location); !next.Equal(want) {
+		t.Errorf("Expected %s, got %s", want, next)
+	}
+}
@@ -0,0 +1,197 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// DefaultLockTTL is how long a WithoutOverlapping lock is held at most, in
+// case the process holding it dies.
+const DefaultLockTTL = 24 * time.Hour
+
+// Event is a scheduled task: a console command or a function. Its methods
+// configure it fluently:
+//
+//	s.Command("reports:send").DailyAt("02:00").Timezone("Europe/Paris")
+//	s.Call(cleanup).EveryFiveMinutes().WithoutOverlapping()
+//
+// Configuration errors, such as an invalid cron expression, are reported by
+// Schedule.Err and when the event would run.
+type Event struct {
+	name    string
+	command string
+	args    []string
+	fn      func(ctx context.Context) error
+
+	expression  string
+	cron        *cronSchedule
+	location    *time.Location
+	overlapping bool
+	lockTTL     time.Duration
+	background  bool
+	err         error
+}
+
+// Name sets the name of the event, shown in listings and used as the key of
+// its overlap lock. It defaults to the command line or the function name.
+func (e *Event) Name(name string) *Event {
+	e.name = name
+	return e
+}
+
+// Cron sets the schedule from a five-field cron expression, e.g.
+// "*/15 9-17 * * mon-fri", or a descriptor such as "@hourly".
+func (e *Event) Cron(expression string) *Event {
+	cron, err := parseCron(expression)
+	if err != nil {
+		e.err = err
+		return e
+	}
+	e.expression, e.cron = expression, cron
+	return e
+}
+
+// EveryMinute runs the event every minute.
+func (e *Event) EveryMinute() *Event { return e.Cron("* * * * *") }
+
+// EveryFiveMinutes runs the event every five minutes.
+func (e *Event) EveryFiveMinutes() *Event { return e.Cron("*/5 * * * *") }
+
+// EveryTenMinutes runs the event every ten minutes.
+func (e *Event) EveryTenMinutes() *Event { return e.Cron("*/10 * * * *") }
+
+// EveryFifteenMinutes runs the event every fifteen minutes.
+func (e *Event) EveryFifteenMinutes() *Event { return e.Cron("*/15 * * * *") }
+
+// EveryThirtyMinutes runs the event every thirty minutes.
+func (e *Event) EveryThirtyMinutes() *Event { return e.Cron("*/30 * * * *") }
+
+// Hourly runs the event at the start of every hour.
+func (e *Event) Hourly() *Event { return e.Cron("0 * * * *") }
+
+// HourlyAt runs the event every hour at the given minute.
+func (e *Event) HourlyAt(minute int) *Event {
+	return e.Cron(fmt.Sprintf("%d * * * *", minute))
+}
+
+// Daily runs the event every day at midnight.
+func (e *Event) Daily() *Event { return e.Cron("0 0 * * *") }
+
+// DailyAt runs the event every day at the given "HH:MM" time.
+func (e *Event) DailyAt(at string) *Event {
+	hour, minute, err := parseTime(at)
+	if err != nil {
+		e.err = err
+		return e
+	}
+	return e.Cron(fmt.Sprintf("%d %d * * *", minute, hour))
+}
+
+// Weekly runs the event every Sunday at midnight.
+func (e *Event) Weekly() *Event { return e.Cron("0 0 * * 0") }
+
+// WeeklyOn runs the event every week on the given day, at the given "HH:MM" time.
+func (e *Event) WeeklyOn(day time.Weekday, at string) *Event {
+	hour, minute, err := parseTime(at)
+	if err != nil {
+		e.err = err
+		return e
+	}
+	return e.Cron(fmt.Sprintf("%d %d * * %d", minute, hour, day))
+}
+
+// Monthly runs the event on the first day of every month at midnight.
+func (e *Event) Monthly() *Event { return e.Cron("0 0 1 * *") }
+
+// Timezone evaluates the schedule in the named time zone, e.g.
+// "America/New_York", instead of the time zone of the schedule.
+func (e *Event) Timezone(name string) *Event {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		e.err = fmt.Errorf("invalid time zone %q: %w", name, err)
+		return e
+	}
+	e.location = location
+	return e
+}
+
+// WithoutOverlapping skips the event while a previous run still holds its
+// lock. The lock expires after DefaultLockTTL, or the given duration.
+func (e *Event) WithoutOverlapping(ttl ...time.Duration) *Event {
+	e.overlapping = true
+	e.lockTTL = DefaultLockTTL
+	if len(ttl) > 0 {
+		e.lockTTL = ttl[0]
+	}
+	return e
+}
+
+// RunInBackground runs the event in its own goroutine, so it does not delay
+// the events due after it.
+func (e *Event) RunInBackground() *Event {
+	e.background = true
+	return e
+}
+
+// Description returns the name of the event.
+func (e *Event) Description() string {
+	if e.name != "" {
+		return e.name
+	}
+	if e.fn != nil {
+		return funcName(e.fn)
+	}
+	return strings.TrimSpace(e.command + " " + strings.Join(e.args, " "))
+}
+
+// Expression returns the cron expression of the event.
+func (e *Event) Expression() string {
+	return e.expression
+}
+
+// Err returns the configuration error of the event, if any.
+func (e *Event) Err() error {
+	if e.err != nil {
+		return fmt.Errorf("event %q: %w", e.Description(), e.err)
+	}
+	if e.cron == nil {
+		return fmt.Errorf("event %q has no schedule", e.Description())
+	}
+	return nil
+}
+
+// IsDue reports whether the event runs in the minute of now.
+func (e *Event) IsDue(now time.Time) bool {
+	return e.Err() == nil && e.cron.matches(now.In(e.location))
+}
+
+// Next returns the next time the event runs after now, or the zero time if
+// it never does.
+func (e *Event) Next(now time.Time) time.Time {
+	if e.Err() != nil {
+		return time.Time{}
+	}
+	return e.cron.next(now.In(e.location))
+}
+
+// parseTime parses an "HH:MM" time of day.
+func parseTime(at string) (hour, minute int, err error) {
+	t, err := time.Parse("15:04", at)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time %q: expected HH:MM", at)
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// funcName returns the name of a function, e.g. "main.cleanup".
+func funcName(fn interface{}) string {
+	if f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()); f != nil {
+		name := f.Name()
+		return name[strings.LastIndex(name, "/")+1:]
+	}
+	return "callback"
+}
@@ -0,0 +1,119 @@
+package schedule
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Locker holds the locks of WithoutOverlapping events.
+type Locker interface {
+	// Lock acquires the lock for key until Unlock is called or ttl elapses.
+	// It reports false if the lock is already held.
+	Lock(key string, ttl time.Duration) (bool, error)
+	// Unlock releases the lock for key.
+	Unlock(key string) error
+}
+
+// memoryLocker holds locks within the process.
+type memoryLocker struct {
+	mu    sync.Mutex
+	locks map[string]time.Time
+}
+
+// NewMemoryLocker creates a Locker whose locks only exist within the process.
+func NewMemoryLocker() Locker {
+	return &memoryLocker{locks: make(map[string]time.Time)}
+}
+
+// Lock acquires the lock for key unless it is held and not expired.
+func (l *memoryLocker) Lock(key string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if expires, ok := l.locks[key]; ok && time.Now().Before(expires) {
+		return false, nil
+	}
+	l.locks[key] = time.Now().Add(ttl)
+	return true, nil
+}
+
+// Unlock releases the lock for key.
+func (l *memoryLocker) Unlock(key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.locks, key)
+	return nil
+}
+
+// fileLocker holds locks as files, shared by every process using the directory.
+type fileLocker struct {
+	dir string
+}
+
+// NewFileLocker creates a Locker that keeps its locks as files in dir, so
+// schedule:run processes started by the system cron do not overlap.
+func NewFileLocker(dir string) Locker {
+	return &fileLocker{dir: dir}
+}
+
+// Lock creates the lock file of key, replacing it if it has expired.
+func (l *fileLocker) Lock(key string, ttl time.Duration) (bool, error) {
+	if err := os.MkdirAll(l.dir, 0o755); err != nil {
+		return false, err
+	}
+
+	path := l.path(key)
+	for attempt := 0; attempt < 2; attempt++ {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err == nil {
+			_, err = file.WriteString(strconv.FormatInt(time.Now().Add(ttl).UnixNano(), 10))
+			return true, errors.Join(err, file.Close())
+		}
+		if !errors.Is(err, fs.ErrExist) {
+			return false, err
+		}
+
+		// Held: take it over only if it has expired
+		data, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		expires, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+		if err == nil && time.Now().UnixNano() < expires {
+			return false, nil
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// Unlock removes the lock file of key.
+func (l *fileLocker) Unlock(key string) error {
+	err := os.Remove(l.path(key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove lock file: %w", err)
+	}
+	return nil
+}
+
+// path returns the lock file of key. Keys are hashed, as they may contain
+// characters that are not valid in file names.
+func (l *fileLocker) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(l.dir, hex.EncodeToString(sum[:16])+".lock")
+}
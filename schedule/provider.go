@@ -0,0 +1,167 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/donnigundala/dg-core/console"
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	coreFoundation "github.com/donnigundala/dg-core/foundation"
+	"github.com/spf13/cobra"
+)
+
+// Provider is implemented by service providers that schedule events. The
+// ServiceProvider asks every registered provider for its events on boot:
+//
+//	func (p *ReportProvider) Schedule(s *schedule.Schedule) {
+//	    s.Command("reports:send").DailyAt("02:00").WithoutOverlapping()
+//	}
+type Provider interface {
+	Schedule(s *Schedule)
+}
+
+// ServiceProvider binds the schedule to the container under "schedule",
+// collects the events of every Provider and adds the schedule:run,
+// schedule:work and schedule:list commands. Command events are run through
+// the console kernel bound under "console", and overlap locks are kept in
+// storage/framework/schedule so they hold across processes.
+type ServiceProvider struct {
+	schedule *Schedule
+}
+
+// Register binds the schedule.
+func (p *ServiceProvider) Register(app foundation.Application) error {
+	p.schedule = New(
+		WithCaller(consoleCaller{app: app}),
+		WithLocker(NewFileLocker(filepath.Join(app.StoragePath(), "framework", "schedule"))),
+	)
+	app.Instance("schedule", p.schedule)
+	return nil
+}
+
+// Boot collects the events of the providers and stops the schedule when the
+// application shuts down, after the servers are drained.
+func (p *ServiceProvider) Boot(app foundation.Application) error {
+	for _, provider := range app.GetProviders() {
+		if scheduler, ok := provider.(Provider); ok {
+			scheduler.Schedule(p.schedule)
+		}
+	}
+
+	if hooks, ok := app.(interface {
+		OnShutdown(name string, fn coreFoundation.ShutdownFunc, opts ...coreFoundation.ShutdownOption)
+	}); ok {
+		hooks.OnShutdown("schedule", p.schedule.Stop, coreFoundation.WithShutdownPhase(coreFoundation.ShutdownPhaseWorkers))
+	}
+
+	return p.schedule.Err()
+}
+
+// Commands returns the schedule commands.
+func (p *ServiceProvider) Commands() []interface{} {
+	return []interface{}{
+		&runCommand{schedule: p.schedule},
+		&workCommand{schedule: p.schedule},
+		&listCommand{schedule: p.schedule},
+	}
+}
+
+// consoleCaller runs commands through the console kernel bound to the
+// container, which is created after the providers are registered.
+type consoleCaller struct {
+	app foundation.Application
+}
+
+func (c consoleCaller) Call(command string, args []string) error {
+	return c.CallContext(context.Background(), command, args)
+}
+
+// CallContext runs the command as part of the command execution of ctx, such
+// as schedule:run, so it shares its IO and --no-interaction.
+func (c consoleCaller) CallContext(ctx context.Context, command string, args []string) error {
+	instance, err := c.app.Make("console")
+	if err != nil {
+		return fmt.Errorf("no console kernel is bound to 'console': %w", err)
+	}
+	if caller, ok := instance.(ContextCaller); ok {
+		return caller.CallContext(ctx, command, args)
+	}
+	caller, ok := instance.(Caller)
+	if !ok {
+		return fmt.Errorf("'console' is a %T, which cannot call commands", instance)
+	}
+	return caller.Call(command, args)
+}
+
+// runCommand runs the due events once. The system cron starts it every minute:
+//
+//   - * * * * cd /path/to/app && ./app schedule:run >> /dev/null 2>&1
+type runCommand struct {
+	schedule *Schedule
+}
+
+func (c *runCommand) Signature() string { return "schedule:run" }
+
+func (c *runCommand) Description() string { return "Run the scheduled events that are due" }
+
+func (c *runCommand) Configure(cmd *cobra.Command) {}
+
+func (c *runCommand) Handle(cmd *cobra.Command, args []string) error {
+	ctx, now := cmd.Context(), time.Now()
+	if len(c.schedule.DueEvents(now)) == 0 {
+		console.IOFrom(cmd).Info("No scheduled events are due.")
+	}
+
+	// Background events must finish before the process exits.
+	return errors.Join(c.schedule.RunDue(ctx, now), c.schedule.Wait(ctx))
+}
+
+// workCommand runs the schedule in the foreground until interrupted.
+type workCommand struct {
+	schedule *Schedule
+}
+
+func (c *workCommand) Signature() string { return "schedule:work" }
+
+func (c *workCommand) Description() string {
+	return "Run the scheduled events every minute until interrupted"
+}
+
+func (c *workCommand) Configure(cmd *cobra.Command) {}
+
+func (c *workCommand) Handle(cmd *cobra.Command, args []string) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	return c.schedule.Work(ctx)
+}
+
+// listCommand lists the scheduled events and when they run next.
+type listCommand struct {
+	schedule *Schedule
+}
+
+func (c *listCommand) Signature() string { return "schedule:list" }
+
+func (c *listCommand) Description() string { return "List the scheduled events" }
+
+func (c *listCommand) Configure(cmd *cobra.Command) {}
+
+func (c *listCommand) Handle(cmd *cobra.Command, args []string) error {
+	now := time.Now()
+	var rows [][]string
+	for _, event := range c.schedule.Events() {
+		next := "-"
+		if t := event.Next(now); !t.IsZero() {
+			next = t.Format(time.RFC3339)
+		}
+		rows = append(rows, []string{event.Description(), event.Expression(), event.location.String(), next})
+	}
+	console.IOFrom(cmd).Table([]string{"EVENT", "EXPRESSION", "TIMEZONE", "NEXT DUE"}, rows)
+	return c.schedule.Err()
+}
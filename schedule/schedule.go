@@ -0,0 +1,286 @@
+// Package schedule runs periodic tasks, console commands or functions, on
+// cron schedules:
+//
+//	s.Command("reports:send").DailyAt("02:00")
+//	s.Call(cleanup).EveryFiveMinutes().WithoutOverlapping()
+//
+// Providers add their events by implementing Provider. The schedule is run
+// either once a minute by the system cron with the schedule:run command, or
+// by a long-running schedule:work process.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Caller runs console commands. The console kernel implements it.
+type Caller interface {
+	Call(command string, args []string) error
+}
+
+// ContextCaller is a Caller that runs commands as part of the command
+// execution of a context. The console kernel implements it, so command events
+// run by schedule:run share its IO.
+type ContextCaller interface {
+	Caller
+	CallContext(ctx context.Context, command string, args []string) error
+}
+
+// Schedule holds the scheduled events and runs the ones that are due.
+type Schedule struct {
+	mu       sync.Mutex
+	events   []*Event
+	caller   Caller
+	locker   Locker
+	location *time.Location
+	logger   *slog.Logger
+
+	running sync.WaitGroup
+	stop    context.CancelFunc
+}
+
+// Option configures a Schedule.
+type Option func(*Schedule)
+
+// WithCaller sets the Caller that runs the command events.
+func WithCaller(caller Caller) Option {
+	return func(s *Schedule) {
+		s.caller = caller
+	}
+}
+
+// WithLocker sets the Locker of WithoutOverlapping events. Defaults to an
+// in-memory locker, which only prevents overlaps within the process.
+func WithLocker(locker Locker) Option {
+	return func(s *Schedule) {
+		s.locker = locker
+	}
+}
+
+// WithLocation sets the default time zone of the events. Defaults to time.Local.
+func WithLocation(location *time.Location) Option {
+	return func(s *Schedule) {
+		s.location = location
+	}
+}
+
+// WithLogger sets the logger of the schedule.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Schedule) {
+		s.logger = logger
+	}
+}
+
+// New creates an empty schedule.
+func New(opts ...Option) *Schedule {
+	s := &Schedule{
+		locker:   NewMemoryLocker(),
+		location: time.Local,
+		logger:   slog.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Command schedules a console command, run through the Caller.
+func (s *Schedule) Command(command string, args ...string) *Event {
+	return s.add(&Event{command: command, args: args})
+}
+
+// Call schedules a function. The context is canceled when the schedule stops.
+func (s *Schedule) Call(fn func(ctx context.Context) error) *Event {
+	return s.add(&Event{fn: fn})
+}
+
+// add adds an event in the time zone of the schedule.
+func (s *Schedule) add(event *Event) *Event {
+	event.location = s.location
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return event
+}
+
+// Events returns the scheduled events, in the order they were added.
+func (s *Schedule) Events() []*Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := make([]*Event, len(s.events))
+	copy(events, s.events)
+	return events
+}
+
+// Err returns the configuration errors of the events, if any.
+func (s *Schedule) Err() error {
+	var errs []error
+	for _, event := range s.Events() {
+		if err := event.Err(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DueEvents returns the events that run in the minute of now.
+func (s *Schedule) DueEvents(now time.Time) []*Event {
+	var due []*Event
+	for _, event := range s.Events() {
+		if event.IsDue(now) {
+			due = append(due, event)
+		}
+	}
+	return due
+}
+
+// RunDue runs the events due in the minute of now. Foreground events run one
+// after the other; background events are started in their own goroutine and
+// can be waited for with Wait. It returns the errors of the foreground events
+// and the configuration errors of the schedule.
+func (s *Schedule) RunDue(ctx context.Context, now time.Time) error {
+	errs := []error{s.Err()}
+
+	for _, event := range s.DueEvents(now) {
+		// Do not start events once the schedule is stopping
+		if ctx.Err() != nil {
+			break
+		}
+
+		if event.background {
+			s.running.Add(1)
+			go func(event *Event) {
+				defer s.running.Done()
+				if err := s.run(ctx, event); err != nil {
+					s.logger.Error("Scheduled event failed", "event", event.Description(), "error", err)
+				}
+			}(event)
+			continue
+		}
+
+		s.running.Add(1)
+		err := s.run(ctx, event)
+		s.running.Done()
+		if err != nil {
+			s.logger.Error("Scheduled event failed", "event", event.Description(), "error", err)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Work runs the due events at the start of every minute until ctx is
+// canceled or Stop is called, then waits for the running events.
+func (s *Schedule) Work(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.mu.Lock()
+	s.stop = cancel
+	s.mu.Unlock()
+
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	s.logger.Info("Schedule worker started", "events", len(s.Events()))
+	for {
+		now := time.Now()
+		timer := time.NewTimer(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.logger.Info("Schedule worker stopping, waiting for running events")
+			return s.Wait(context.WithoutCancel(ctx))
+		case tick := <-timer.C:
+			// Errors are logged by RunDue; the worker keeps going.
+			_ = s.RunDue(ctx, tick)
+		}
+	}
+}
+
+// Wait waits for the running events to finish, or for ctx to be done.
+func (s *Schedule) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.running.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("scheduled events still running: %w", ctx.Err())
+	}
+}
+
+// Stop stops Work, if it is running, and waits for the running events until
+// ctx is done. The application calls it on shutdown.
+func (s *Schedule) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	stop := s.stop
+	s.mu.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+	return s.Wait(ctx)
+}
+
+// run runs an event, holding its lock when it must not overlap. A panic is
+// reported as an error.
+func (s *Schedule) run(ctx context.Context, event *Event) (err error) {
+	if event.overlapping {
+		key := "schedule:" + event.Description()
+		locked, err := s.locker.Lock(key, event.lockTTL)
+		if err != nil {
+			return fmt.Errorf("failed to lock event %q: %w", event.Description(), err)
+		}
+		if !locked {
+			s.logger.Info("Skipping scheduled event, still running", "event", event.Description())
+			return nil
+		}
+		defer func() {
+			if unlockErr := s.locker.Unlock(key); unlockErr != nil {
+				s.logger.Error("Failed to unlock scheduled event", "event", event.Description(), "error", unlockErr)
+			}
+		}()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("event %q panicked: %v", event.Description(), r)
+		}
+	}()
+
+	start := time.Now()
+	s.logger.Info("Running scheduled event", "event", event.Description())
+
+	if event.fn != nil {
+		err = event.fn(ctx)
+	} else if s.caller == nil {
+		err = errors.New("no Caller is configured to run commands")
+	} else if caller, ok := s.caller.(ContextCaller); ok {
+		err = caller.CallContext(ctx, event.command, event.args)
+	} else {
+		err = s.caller.Call(event.command, event.args)
+	}
+	if err != nil {
+		return fmt.Errorf("event %q failed: %w", event.Description(), err)
+	}
+
+	s.logger.Info("Scheduled event finished", "event", event.Description(), "duration", time.Since(start))
+	return nil
+}
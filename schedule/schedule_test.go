@@ -0,0 +1,288 @@
+package schedule_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/donnigundala/dg-core/console"
+	contractConsole "github.com/donnigundala/dg-core/contracts/console"
+	"github.com/donnigundala/dg-core/contracts/foundation"
+	coreFoundation "github.com/donnigundala/dg-core/foundation"
+	"github.com/donnigundala/dg-core/schedule"
+	"github.com/spf13/cobra"
+)
+
+// recordingCaller records the commands it is asked to call.
+type recordingCaller struct {
+	calls []string
+}
+
+func (c *recordingCaller) Call(command string, args []string) error {
+	c.calls = append(c.calls, strings.TrimSpace(command+" "+strings.Join(args, " ")))
+	return nil
+}
+
+var monday0200 = time.Date(2025, 3, 10, 2, 0, 0, 0, time.UTC)
+
+// TestSchedule_RunDue tests that only the due events run.
+func TestSchedule_RunDue(t *testing.T) {
+	caller := &recordingCaller{}
+	s := schedule.New(schedule.WithCaller(caller), schedule.WithLocation(time.UTC))
+
+	s.Command("reports:send", "--daily").DailyAt("02:00")
+	s.Command("cache:prune").HourlyAt(30)
+	s.Command("backup:run").WeeklyOn(time.Sunday, "02:00")
+
+	var calls int32
+	s.Call(func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	}).EveryFiveMinutes()
+
+	if err := s.RunDue(context.Background(), monday0200); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(caller.calls) != 1 || caller.calls[0] != "reports:send --daily" {
+		t.Errorf("Expected reports:send to run, got %v", caller.calls)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the callback to run once, ran %d times", calls)
+	}
+}
+
+// TestSchedule_Timezone tests that events are due on the clock of their time zone.
+func TestSchedule_Timezone(t *testing.T) {
+	s := schedule.New(schedule.WithLocation(time.UTC))
+	event := s.Call(func(ctx context.Context) error { return nil }).DailyAt("09:00").Timezone("Asia/Tokyo")
+	if err := event.Err(); err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	// 00:00 UTC is 09:00 in Tokyo
+	if !event.IsDue(time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Expected the event to be due at 09:00 Tokyo time")
+	}
+	if event.IsDue(time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)) {
+		t.Error("Expected the event not to be due at 09:00 UTC")
+	}
+}
+
+// TestSchedule_InvalidEvents tests that configuration errors are reported.
+func TestSchedule_InvalidEvents(t *testing.T) {
+	s := schedule.New()
+	s.Command("a").Cron("61 * * * *")
+	s.Command("b").DailyAt("25:00")
+	s.Command("c").Daily().Timezone("Mars/Olympus")
+	s.Command("d")
+
+	err := s.Err()
+	for _, want := range []string{`event "a"`, `event "b"`, `event "c"`, `event "d" has no schedule`} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to contain %q, got %v", want, err)
+		}
+	}
+}
+
+// TestSchedule_WithoutOverlapping tests that a locked event is skipped.
+func TestSchedule_WithoutOverlapping(t *testing.T) {
+	locker := schedule.NewFileLocker(t.TempDir())
+	s := schedule.New(schedule.WithLocker(locker), schedule.WithLocation(time.UTC))
+
+	var runs int32
+	s.Call(func(ctx context.Context) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	}).Name("cleanup").EveryMinute().WithoutOverlapping()
+
+	// Another process holds the lock
+	if locked, err := locker.Lock("schedule:cleanup", time.Minute); !locked || err != nil {
+		t.Fatalf("Expected to acquire the lock, got %v, %v", locked, err)
+	}
+	s.RunDue(context.Background(), monday0200)
+	if runs != 0 {
+		t.Fatalf("Expected the locked event to be skipped, ran %d times", runs)
+	}
+
+	locker.Unlock("schedule:cleanup")
+	s.RunDue(context.Background(), monday0200)
+	s.RunDue(context.Background(), monday0200)
+	if runs != 2 {
+		t.Errorf("Expected the event to run twice, ran %d times", runs)
+	}
+}
+
+// TestFileLocker_Expires tests that an expired lock can be taken over.
+func TestFileLocker_Expires(t *testing.T) {
+	locker := schedule.NewFileLocker(t.TempDir())
+
+	if ok, _ := locker.Lock("job", -time.Second); !ok {
+		t.Fatal("Expected to acquire the lock")
+	}
+	if ok, _ := locker.Lock("job", time.Minute); !ok {
+		t.Fatal("Expected to take over the expired lock")
+	}
+	if ok, _ := locker.Lock("job", time.Minute); ok {
+		t.Error("Expected the held lock to be refused")
+	}
+}
+
+// TestSchedule_BackgroundAndStop tests that Stop waits for background events.
+func TestSchedule_BackgroundAndStop(t *testing.T) {
+	s := schedule.New(schedule.WithLocation(time.UTC))
+	release := make(chan struct{})
+	var finished atomic.Bool
+	s.Call(func(ctx context.Context) error {
+		<-release
+		finished.Store(true)
+		return nil
+	}).EveryMinute().RunInBackground()
+
+	var foreground atomic.Bool
+	s.Call(func(ctx context.Context) error {
+		foreground.Store(true)
+		return errors.New("report failed")
+	}).EveryMinute()
+
+	err := s.RunDue(context.Background(), monday0200)
+	if err == nil || !strings.Contains(err.Error(), "report failed") {
+		t.Errorf("Expected the foreground error, got %v", err)
+	}
+	if !foreground.Load() {
+		t.Error("Expected the foreground event to run without waiting for the background one")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := s.Stop(ctx); err == nil {
+		t.Error("Expected Stop to time out while the background event runs")
+	}
+
+	close(release)
+	if err := s.Stop(context.Background()); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !finished.Load() {
+		t.Error("Expected the background event to finish")
+	}
+}
+
+// TestSchedule_PanicIsReported tests that a panicking event is reported as an error.
+func TestSchedule_PanicIsReported(t *testing.T) {
+	s := schedule.New(schedule.WithLocation(time.UTC))
+	s.Call(func(ctx context.Context) error { panic("boom") }).Name("explode").EveryMinute()
+
+	err := s.RunDue(context.Background(), monday0200)
+	if err == nil || !strings.Contains(err.Error(), `event "explode" panicked: boom`) {
+		t.Errorf("Expected a panic error, got %v", err)
+	}
+}
+
+// pingCommand counts its runs.
+type pingCommand struct {
+	runs int
+}
+
+func (c *pingCommand) Signature() string                              { return "ping" }
+func (c *pingCommand) Description() string                            { return "Ping" }
+func (c *pingCommand) Configure(cmd *cobra.Command)                   {}
+func (c *pingCommand) Handle(cmd *cobra.Command, args []string) error { c.runs++; return nil }
+
+// pingProvider schedules the ping command.
+type pingProvider struct{}
+
+func (p *pingProvider) Register(app foundation.Application) error { return nil }
+func (p *pingProvider) Boot(app foundation.Application) error     { return nil }
+func (p *pingProvider) Schedule(s *schedule.Schedule)             { s.Command("ping").EveryMinute() }
+
+// TestServiceProvider_RunsProviderEvents tests schedule:run with events from providers.
+func TestServiceProvider_RunsProviderEvents(t *testing.T) {
+	app := coreFoundation.New(t.TempDir())
+	app.Register(&schedule.ServiceProvider{})
+	app.Register(&pingProvider{})
+
+	ping := &pingCommand{}
+	kernel := console.NewKernel(app)
+	out := &bytes.Buffer{}
+	kernel.SetOut(out)
+	kernel.Register([]contractConsole.Command{ping})
+
+	if err := kernel.Call("schedule:run", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if ping.runs != 1 {
+		t.Errorf("Expected ping to run once, ran %d times", ping.runs)
+	}
+
+	out.Reset()
+	if err := kernel.Call("schedule:list", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(out.String(), "ping") || !strings.Contains(out.String(), "* * * * *") {
+		t.Errorf("Expected the ping event to be listed, got %q", out.String())
+	}
+
+	// The schedule is stopped with the application
+	report, err := app.ShutdownWithReport(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if report.Hooks[0].Name != "schedule" {
+		t.Errorf("Expected the schedule to stop first, got %q", report.Hooks[0].Name)
+	}
+}
+
+// countCommand counts its runs, which last long enough to overlap.
+type countCommand struct {
+	name string
+	runs atomic.Int32
+}
+
+func (c *countCommand) Signature() string            { return c.name }
+func (c *countCommand) Description() string          { return "Count" }
+func (c *countCommand) Configure(cmd *cobra.Command) {}
+func (c *countCommand) Handle(cmd *cobra.Command, args []string) error {
+	if console.IOFrom(cmd).IsInteractive() {
+		return errors.New("expected the command to run without interaction")
+	}
+	time.Sleep(10 * time.Millisecond)
+	c.runs.Add(1)
+	return nil
+}
+
+// backgroundProvider schedules the count commands in the background.
+type backgroundProvider struct{}
+
+func (p *backgroundProvider) Register(app foundation.Application) error { return nil }
+func (p *backgroundProvider) Boot(app foundation.Application) error     { return nil }
+func (p *backgroundProvider) Schedule(s *schedule.Schedule) {
+	s.Command("count:a").EveryMinute().RunInBackground()
+	s.Command("count:b").EveryMinute().RunInBackground()
+}
+
+// TestServiceProvider_BackgroundCommands tests that background command events
+// run concurrently through the kernel, as part of the schedule:run execution.
+func TestServiceProvider_BackgroundCommands(t *testing.T) {
+	app := coreFoundation.New(t.TempDir())
+	app.Register(&schedule.ServiceProvider{})
+	app.Register(&backgroundProvider{})
+
+	a, b := &countCommand{name: "count:a"}, &countCommand{name: "count:b"}
+	kernel := console.NewKernel(app)
+	kernel.SetOut(&bytes.Buffer{})
+	kernel.Register([]contractConsole.Command{a, b})
+
+	for i := 0; i < 5; i++ {
+		if err := kernel.Call("schedule:run", []string{"--no-interaction"}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if a.runs.Load() != 5 || b.runs.Load() != 5 {
+		t.Errorf("Expected both commands to run 5 times, ran %d and %d times", a.runs.Load(), b.runs.Load())
+	}
+}
@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/donnigundala/dg-core/console"
+	"github.com/donnigundala/dg-core/contracts/foundation"
+)
+
+// Console runs the console commands of an application in tests.
+type Console struct {
+	kernel  *console.Kernel
+	answers []string
+}
+
+// NewConsole creates a test console for app. It uses the console kernel bound
+// to the app under "console", if any, so the commands registered with it are
+// available; otherwise it creates one.
+func NewConsole(app foundation.Application) *Console {
+	if instance, err := app.Make("console"); err == nil {
+		if kernel, ok := instance.(*console.Kernel); ok {
+			return &Console{kernel: kernel}
+		}
+	}
+	return &Console{kernel: console.NewKernel(app)}
+}
+
+// Kernel returns the console kernel, to register commands with it.
+func (c *Console) Kernel() *console.Kernel {
+	return c.kernel
+}
+
+// WithAnswers sets the answers of the prompts of the next run, in order.
+// Once they are used up, prompts return their default.
+func (c *Console) WithAnswers(answers ...string) *Console {
+	c.answers = append(c.answers, answers...)
+	return c
+}
+
+// Run runs a command with its arguments and flags, capturing its output:
+//
+//	NewConsole(app).WithAnswers("yes").Run("migrate:up", "--force").
+//	    WithT(t).
+//	    AssertExitCode(0).
+//	    AssertOutputContains("Migrated")
+func (c *Console) Run(command string, args ...string) *CommandResult {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	input := ""
+	if len(c.answers) > 0 {
+		input = strings.Join(c.answers, "\n") + "\n"
+	}
+	c.answers = nil
+
+	c.kernel.SetIn(strings.NewReader(input))
+	c.kernel.SetOut(stdout)
+	c.kernel.SetErr(stderr)
+
+	err := c.kernel.Call(command, args)
+	exitCode := 0
+	if err != nil {
+		exitCode = 1
+	}
+
+	return &CommandResult{
+		ExitCode: exitCode,
+		Stdout:   stdout.String(),
+		Stderr:   stderr.String(),
+		Err:      err,
+	}
+}
+
+// CommandResult is the outcome of a command run by a Console.
+type CommandResult struct {
+	// ExitCode is 0 when the command succeeded, and 1 when it returned an error.
+	ExitCode int
+	Stdout   string
+	Stderr   string
+	Err      error
+	t        *testing.T
+}
+
+// WithT sets the testing.T for assertions.
+func (r *CommandResult) WithT(t *testing.T) *CommandResult {
+	r.t = t
+	return r
+}
+
+// AssertExitCode asserts the exit code of the command.
+func (r *CommandResult) AssertExitCode(expected int) *CommandResult {
+	if r.t != nil {
+		if r.ExitCode != expected {
+			r.t.Errorf("Expected exit code %d, got %d (error: %v)\n\nStderr:\n%s", expected, r.ExitCode, r.Err, r.Stderr)
+		}
+	}
+	return r
+}
+
+// AssertOutputContains asserts the output of the command contains a string.
+func (r *CommandResult) AssertOutputContains(expected string) *CommandResult {
+	if r.t != nil {
+		if !strings.Contains(r.Stdout, expected) {
+			r.t.Errorf("Expected output to contain: %s\n\nGot:\n%s", expected, r.Stdout)
+		}
+	}
+	return r
+}
+
+// AssertErrorOutputContains asserts the error output of the command contains a string.
+func (r *CommandResult) AssertErrorOutputContains(expected string) *CommandResult {
+	if r.t != nil {
+		if !strings.Contains(r.Stderr, expected) {
+			r.t.Errorf("Expected error output to contain: %s\n\nGot:\n%s", expected, r.Stderr)
+		}
+	}
+	return r
+}
+
+// AssertTableRow asserts the output has a table row with exactly the given
+// cells, as written by console.Output.Table.
+func (r *CommandResult) AssertTableRow(cells ...string) *CommandResult {
+	if r.t != nil {
+		for _, row := range r.TableRows() {
+			if slices.Equal(row, cells) {
+				return r
+			}
+		}
+		r.t.Errorf("Expected a table row %q\n\nGot:\n%s", cells, r.Stdout)
+	}
+	return r
+}
+
+// TableRows returns the rows of the tables in the output, headers included.
+func (r *CommandResult) TableRows() [][]string {
+	var rows [][]string
+	for _, line := range strings.Split(r.Stdout, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) < 2 || !strings.HasPrefix(line, "|") || !strings.HasSuffix(line, "|") {
+			continue
+		}
+
+		cells := strings.Split(line[1:len(line)-1], "|")
+		for i, cell := range cells {
+			cells[i] = strings.TrimSpace(cell)
+		}
+		rows = append(rows, cells)
+	}
+	return rows
+}
@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/donnigundala/dg-core/console"
+	contractConsole "github.com/donnigundala/dg-core/contracts/console"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// MigrateCommand asks for confirmation, then lists the migrations it ran.
+type MigrateCommand struct{}
+
+func (c *MigrateCommand) Signature() string   { return "migrate:up" }
+func (c *MigrateCommand) Description() string { return "Run the migrations" }
+func (c *MigrateCommand) Configure(cmd *cobra.Command) {
+	cmd.Flags().Bool("force", false, "Run without confirmation")
+	cmd.Flags().Bool("fail", false, "Fail the migration")
+}
+
+func (c *MigrateCommand) Handle(cmd *cobra.Command, args []string) error {
+	io := console.IOFrom(cmd)
+	if fail, _ := cmd.Flags().GetBool("fail"); fail {
+		return errors.New("migration failed")
+	}
+	if force, _ := cmd.Flags().GetBool("force"); !force {
+		if ok, err := io.Confirm("Run the migrations?", false); !ok || err != nil {
+			io.Warn("Cancelled")
+			return err
+		}
+	}
+
+	io.Table([]string{"Migration", "Status"}, [][]string{{"create_users", "done"}})
+	io.Info("Migrated")
+	return nil
+}
+
+func TestConsole(t *testing.T) {
+	app := NewTestApp()
+	c := NewConsole(app)
+	c.Kernel().Register([]contractConsole.Command{&MigrateCommand{}})
+
+	// 1. Flags skip the prompt
+	c.Run("migrate:up", "--force").
+		WithT(t).
+		AssertExitCode(0).
+		AssertOutputContains("Migrated").
+		AssertTableRow("create_users", "done")
+
+	// 2. Scripted answers feed the prompts, for the next run only
+	c.WithAnswers("no").Run("migrate:up").
+		WithT(t).
+		AssertExitCode(0).
+		AssertOutputContains("Cancelled")
+	c.Run("migrate:up").WithT(t).AssertOutputContains("Cancelled")
+	c.WithAnswers("yes").Run("migrate:up").WithT(t).AssertOutputContains("Migrated")
+
+	// 3. Errors set the exit code and are written to stderr
+	result := c.Run("migrate:up", "--fail").
+		WithT(t).
+		AssertExitCode(1).
+		AssertErrorOutputContains("migration failed")
+	assert.EqualError(t, result.Err, "migration failed")
+
+	// 4. The kernel bound to the app is reused
+	assert.Same(t, c.Kernel(), NewConsole(app).Kernel())
+}
+
+func TestCommandResult_TableRows(t *testing.T) {
+	result := &CommandResult{Stdout: "+---+----+\n| a | bb |\n+---+----+\n| 1 |    |\n+---+----+\n"}
+
+	assert.Equal(t, [][]string{{"a", "bb"}, {"1", ""}}, result.TableRows())
+}
@@ -1,6 +1,8 @@
 package testing
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/donnigundala/dg-core/contracts/foundation"
@@ -13,8 +15,56 @@ func NewTestApp() *coreFoundation.Application {
 	return coreFoundation.New("/tmp/test")
 }
 
+// TestApp is an application shared by tests, which override its bindings on
+// forks so they never affect each other.
+type TestApp struct {
+	*coreFoundation.Application
+	forked bool
+}
+
+// NewSharedTestApp creates an application to register and boot once, then
+// share between tests through Fork:
+//
+//	var baseApp = testing.NewSharedTestApp()
+//
+//	func TestSignup(t *testing.T) {
+//	    t.Parallel()
+//	    app := baseApp.Fork(t)
+//	    app.Override("mailer", &FakeMailer{})
+//	}
+func NewSharedTestApp() *TestApp {
+	return &TestApp{Application: NewTestApp()}
+}
+
+// Fork returns a test app backed by a child container of app, with its
+// providers, boot state, shutdown hooks and lifecycle listeners. The fork
+// resolves everything registered on app, while its overrides and the
+// singletons it builds stay private to it; those singletons are disposed
+// when the test finishes. Singletons app has already built are shared as
+// they are.
+func (app *TestApp) Fork(t testing.TB) *TestApp {
+	fork := &TestApp{Application: app.Application.Fork(), forked: true}
+	t.Cleanup(func() {
+		if err := fork.Dispose(context.Background()); err != nil {
+			t.Errorf("Failed to dispose forked app: %v", err)
+		}
+	})
+	return fork
+}
+
+// Override replaces the binding for key with the given instance on a fork.
+// Singletons built afterwards by the fork receive the override through the
+// container passed to their resolver. It panics on the shared app
+// itself, which every test sees; call Fork first.
+func (app *TestApp) Override(key string, instance interface{}) {
+	if !app.forked {
+		panic(fmt.Sprintf("testing: Override(%q) on a shared TestApp would affect every test; call Fork first", key))
+	}
+	app.Instance(key, instance)
+}
+
 // RegisterAndBoot registers and boots a provider, failing the test on error.
-func RegisterAndBoot(t *testing.T, app *coreFoundation.Application, provider foundation.ServiceProvider) {
+func RegisterAndBoot(t *testing.T, app foundation.Application, provider foundation.ServiceProvider) {
 	err := app.Register(provider)
 	assert.NoError(t, err, "Failed to register provider")
 
@@ -23,13 +73,13 @@ func RegisterAndBoot(t *testing.T, app *coreFoundation.Application, provider fou
 }
 
 // AssertBound asserts that a key is bound in the container.
-func AssertBound(t *testing.T, app *coreFoundation.Application, key string) {
+func AssertBound(t *testing.T, app foundation.Application, key string) {
 	_, err := app.Make(key)
 	assert.NoError(t, err, "Expected %s to be bound", key)
 }
 
 // AssertResolved asserts that a key can be resolved from the container.
-func AssertResolved(t *testing.T, app *coreFoundation.Application, key string) interface{} {
+func AssertResolved(t *testing.T, app foundation.Application, key string) interface{} {
 	instance, err := app.Make(key)
 	assert.NoError(t, err, "Failed to resolve %s", key)
 	assert.NotNil(t, instance, "Resolved instance for %s is nil", key)
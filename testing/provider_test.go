@@ -3,6 +3,7 @@ package testing
 import (
 	"testing"
 
+	"github.com/donnigundala/dg-core/contracts/container"
 	"github.com/donnigundala/dg-core/contracts/foundation"
 	"github.com/stretchr/testify/assert"
 )
@@ -45,3 +46,39 @@ func TestProviderUtilities(t *testing.T) {
 	instance := AssertResolved(t, app, "mock_service")
 	assert.Equal(t, "mock_value", instance)
 }
+
+func TestTestApp_Override(t *testing.T) {
+	base := NewSharedTestApp()
+	base.Instance("mailer", "smtp")
+	base.Singleton("notifier", func(c container.Container) interface{} {
+		// Resolvers see the overrides of the fork building them
+		mailer, _ := c.Make("mailer")
+		return "notifier:" + mailer.(string)
+	})
+	RegisterAndBoot(t, base, &MockProvider{})
+
+	for _, mailer := range []string{"fake-a", "fake-b"} {
+		mailer := mailer
+		t.Run(mailer, func(t *testing.T) {
+			t.Parallel()
+
+			app := base.Fork(t)
+			app.Override("mailer", mailer)
+
+			notifier := AssertResolved(t, app, "notifier")
+			assert.Equal(t, "notifier:"+mailer, notifier)
+			assert.Same(t, app.Application, AssertResolved(t, app, "app"))
+
+			// The fork keeps the lifecycle state of the shared app
+			assert.True(t, app.IsBooted())
+			assert.Len(t, app.GetProviders(), 1)
+		})
+	}
+
+	assert.Panics(t, func() { base.Override("mailer", "fake") })
+
+	t.Cleanup(func() {
+		notifier := AssertResolved(t, base, "notifier")
+		assert.Equal(t, "notifier:smtp", notifier)
+	})
+}